@@ -30,6 +30,19 @@ type (
 		Password string `json:"password"`
 		Database string `json:"database"`
 	}
+
+	// CacheConfig 语言资源快照缓存配置。缓存只在本进程内失效，多副本部署时其他副本的修改在快照过期后才可见
+	CacheConfig struct {
+		TTL        int   `json:"ttl"`         // 快照过期时间(秒)，0 表示不过期，多副本部署时不能为 0
+		MaxEntries int   `json:"max_entries"` // 最多缓存的语言快照数，0 表示不限制
+		MaxBytes   int64 `json:"max_bytes"`   // 缓存占用内存上限(字节)，0 表示不限制
+	}
+
+	// TrashConfig 回收站配置
+	TrashConfig struct {
+		RetentionDays int `json:"retention_days"` // 软删除数据的保留天数，超过后永久删除，0 表示不清理
+		PurgeInterval int `json:"purge_interval"` // 清理间隔(秒)
+	}
 )
 
 // LoadAppConfig 从 app.yaml 文件中加载应用程序配置
@@ -0,0 +1,124 @@
+// migration/migration.go
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"i18n-service/data/entity"
+	"log"
+	"sort"
+
+	"xorm.io/xorm"
+)
+
+// lockName 执行迁移时持有的 MySQL 命名锁，避免多个实例同时迁移
+const lockName = "i18n_schema_migrations"
+
+// lockTimeout 等待命名锁的秒数
+const lockTimeout = 60
+
+// ErrLocked 其他实例正在执行迁移
+var ErrLocked = errors.New("schema migration is locked by another instance")
+
+// Migration 一个版本的结构变更。MySQL 的 DDL 会隐式提交，Up 需要可以在部分执行后重新执行
+type Migration struct {
+	Version int64                    // 版本号，按从小到大的顺序执行
+	Name    string                   // 名称
+	Up      func(*xorm.Engine) error // 变更
+}
+
+// Status 迁移的执行状态
+type Status struct {
+	Migration
+	Applied bool // 是否已执行
+}
+
+// Migrations 获取所有迁移，按版本号排序
+func Migrations() []Migration {
+	list := make([]Migration, len(migrations))
+	copy(list, migrations)
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	return list
+}
+
+// Migrate 按版本号顺序执行所有未执行的迁移，并在 schema_migrations 中记录执行的版本。
+// 执行期间持有 MySQL 命名锁，同时启动的其他实例会等待迁移完成后跳过已执行的版本。
+// 返回值：
+//
+//	[]Migration: 本次执行的迁移
+//	error: 错误信息，某个迁移失败时停止，之前的迁移已记录
+func Migrate(db *xorm.Engine) ([]Migration, error) {
+	ctx := context.Background()
+	conn, err := db.DB().Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := lock(ctx, conn); err != nil {
+		return nil, err
+	}
+	defer conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", lockName)
+
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return nil, err
+	}
+	var done []Migration
+	for _, m := range Migrations() {
+		if applied[m.Version] {
+			continue
+		}
+		log.Printf("applying schema migration %d %s", m.Version, m.Name)
+		if err := m.Up(db); err != nil {
+			return done, fmt.Errorf("schema migration %d %s: %w", m.Version, m.Name, err)
+		}
+		if _, err := db.Insert(&entity.SchemaMigrations{Version: m.Version, Name: m.Name}); err != nil {
+			return done, err
+		}
+		done = append(done, m)
+	}
+	return done, nil
+}
+
+// GetStatus 获取所有迁移的执行状态
+func GetStatus(db *xorm.Engine) ([]Status, error) {
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return nil, err
+	}
+	var list []Status
+	for _, m := range Migrations() {
+		list = append(list, Status{Migration: m, Applied: applied[m.Version]})
+	}
+	return list, nil
+}
+
+// appliedVersions 获取已执行的版本，schema_migrations 不存在时创建
+func appliedVersions(db *xorm.Engine) (map[int64]bool, error) {
+	if err := db.Sync(new(entity.SchemaMigrations)); err != nil {
+		return nil, err
+	}
+	var rows []entity.SchemaMigrations
+	if err := db.Find(&rows); err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]bool, len(rows))
+	for _, v := range rows {
+		applied[v.Version] = true
+	}
+	return applied, nil
+}
+
+// lock 获取命名锁，命名锁与连接绑定，释放连接时自动释放
+func lock(ctx context.Context, conn *sql.Conn) error {
+	var ok sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, lockTimeout).Scan(&ok); err != nil {
+		return err
+	}
+	if !ok.Valid || ok.Int64 != 1 {
+		return ErrLocked
+	}
+	return nil
+}
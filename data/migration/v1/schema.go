@@ -0,0 +1,100 @@
+// migration/v1/schema.go
+
+// Package v1 版本 1 创建数据表时的表结构快照。
+// 结构名与 entity 中的相同，表名按同样的规则映射；此后的结构变更由新的迁移版本完成，这里的结构不能修改
+package v1
+
+import "time"
+
+// cultures
+type CulturesResources struct {
+	ID         int32  `xorm:"pk autoincr 'id'" json:"id"`                   //主键ID
+	Name       string `xorm:"varchar(50) 'name'" json:"name"`               //名称
+	Code       string `xorm:"varchar(10) 'code'" json:"code"`               //代码
+	IsDefault  bool   `xorm:"'is_default'" json:"is_default"`               //是否默认
+	ParentID   int32  `xorm:"'parent_id'" json:"parent_id"`                 //回退父语言ID
+	NativeName string `xorm:"varchar(50) 'native_name'" json:"native_name"` //本地名称，例如 Deutsch、العربية
+	Direction  string `xorm:"varchar(3) 'direction'" json:"direction"`      //文字方向 ltr/rtl，为空时根据语言代码推断
+	PluralRule string `xorm:"varchar(10) 'plural_rule'" json:"plural_rule"` //CLDR复数规则使用的语言，为空时使用语言代码
+	Status     int32  `xorm:"notnull default 0 'status'" json:"status"`     //状态 0启用 1测试 2停用，停用的语言不向正式客户端提供
+}
+
+type CulturesResourceTypes struct {
+	ID        int32     `xorm:"pk autoincr 'id'" json:"id"`                             //主键ID
+	ProjectID int32     `xorm:"notnull default 0 index 'project_id'" json:"project_id"` //项目ID，0 为默认项目
+	Name      string    `xorm:"varchar(50) 'name'" json:"name"`                         //名称
+	Remark    string    `xorm:"varchar(255) 'remark'" json:"remark"`                    //备注
+	DeletedAt time.Time `xorm:"deleted 'deleted_at'" json:"deleted_at"`                 //删除时间，软删除
+}
+
+type CulturesResourceKeys struct {
+	ID           int32     `xorm:"pk autoincr 'id'" json:"id"`                             //主键ID
+	ProjectID    int32     `xorm:"notnull default 0 index 'project_id'" json:"project_id"` //项目ID，0 为默认项目
+	Name         string    `xorm:"varchar(100) index 'name'" json:"name"`                  //名称
+	TypeID       int32     `xorm:"index 'type_id'" json:"type_id"`                         //类型ID
+	Description  string    `xorm:"varchar(500) 'description'" json:"description"`          //描述，例如使用位置
+	Context      string    `xorm:"text 'context'" json:"context"`                          //给翻译人员的上下文说明
+	MaxLength    int32     `xorm:"'max_length'" json:"max_length"`                         //翻译的最大字符数，0 表示不限制
+	Placeholders []string  `xorm:"json 'placeholders'" json:"placeholders"`                //文本中的占位符，例如 {name}
+	DeletedAt    time.Time `xorm:"deleted 'deleted_at'" json:"deleted_at"`                 //删除时间，软删除
+}
+
+type CulturesResourceLangs struct {
+	ID         int64             `xorm:"pk autoincr 'id'" json:"id"`               //主键ID
+	KeyID      int32             `xorm:"'key_id'" json:"key_id"`                   //keyID
+	CultureID  int32             `xorm:"'culture_id'" json:"culture_id"`           //cultureID
+	Text       string            `xorm:"varchar(500) 'text'" json:"text"`          //文本
+	Plurals    map[string]string `xorm:"-" json:"plurals,omitempty"`               //复数形式，CLDR复数类别 -> 文本，存储于 CulturesResourcePlurals
+	Status     int32             `xorm:"notnull default 2 'status'" json:"status"` //审核状态 0草稿 1待审核 2已通过 3已驳回，已有数据默认为已通过
+	Reviewer   string            `xorm:"varchar(50) 'reviewer'" json:"reviewer"`   //审核人
+	ReviewedAt *time.Time        `xorm:"'reviewed_at'" json:"reviewed_at"`         //审核时间
+	CreatedAt  time.Time         `xorm:"created 'created_at'" json:"created_at"`   //创建时间
+	UpdatedAt  time.Time         `xorm:"updated 'updated_at'" json:"updated_at"`   //更新时间
+	DeletedAt  time.Time         `xorm:"deleted 'deleted_at'" json:"deleted_at"`   //删除时间，随资源键软删除
+}
+
+type CulturesResourcePlurals struct {
+	ID        int64  `xorm:"pk autoincr 'id'" json:"id"`             //主键ID
+	KeyID     int32  `xorm:"index 'key_id'" json:"key_id"`           //keyID
+	CultureID int32  `xorm:"'culture_id'" json:"culture_id"`         //cultureID
+	Category  string `xorm:"varchar(10) 'category'" json:"category"` //CLDR复数类别 zero/one/two/few/many/other
+	Text      string `xorm:"varchar(500) 'text'" json:"text"`        //文本
+}
+
+type CulturesResourceHistories struct {
+	ID        int64     `xorm:"pk autoincr 'id'" json:"id"`                             //主键ID
+	Entity    string    `xorm:"varchar(10) index(idx_entity) 'entity'" json:"entity"`   //实体类型 lang/key/type
+	EntityID  int64     `xorm:"index(idx_entity) 'entity_id'" json:"entity_id"`         //实体ID
+	ProjectID int32     `xorm:"notnull default 0 index 'project_id'" json:"project_id"` //项目ID
+	KeyID     int32     `xorm:"index 'key_id'" json:"key_id"`                           //keyID，资源类型的记录为0
+	CultureID int32     `xorm:"'culture_id'" json:"culture_id"`                         //cultureID，资源语言以外的记录为0
+	Action    string    `xorm:"varchar(10) 'action'" json:"action"`                     //操作 create/update/delete
+	OldValue  string    `xorm:"text 'old_value'" json:"old_value"`                      //修改前的值(JSON)，新增时为空
+	NewValue  string    `xorm:"text 'new_value'" json:"new_value"`                      //修改后的值(JSON)，删除时为空
+	Actor     string    `xorm:"varchar(50) 'actor'" json:"actor"`                       //操作人
+	CreatedAt time.Time `xorm:"created 'created_at'" json:"created_at"`                 //操作时间
+}
+
+type CulturesResourceTags struct {
+	ID   int32  `xorm:"pk autoincr 'id'" json:"id"`            //主键ID
+	Name string `xorm:"varchar(50) unique 'name'" json:"name"` //标签名称，例如 release-2026.11
+}
+
+type CulturesResourceKeyTags struct {
+	ID    int64 `xorm:"pk autoincr 'id'" json:"id"`                   //主键ID
+	KeyID int32 `xorm:"unique(key_tag) 'key_id'" json:"key_id"`       //keyID
+	TagID int32 `xorm:"unique(key_tag) index 'tag_id'" json:"tag_id"` //标签ID
+}
+
+type CulturesProjects struct {
+	ID     int32  `xorm:"pk autoincr 'id'" json:"id"`            //主键ID
+	Code   string `xorm:"varchar(50) unique 'code'" json:"code"` //项目代码，请求中使用
+	Name   string `xorm:"varchar(100) 'name'" json:"name"`       //名称
+	Remark string `xorm:"varchar(255) 'remark'" json:"remark"`   //备注
+}
+
+type CulturesProjectCultures struct {
+	ID        int64 `xorm:"pk autoincr 'id'" json:"id"`                             //主键ID
+	ProjectID int32 `xorm:"unique(project_culture) 'project_id'" json:"project_id"` //项目ID
+	CultureID int32 `xorm:"unique(project_culture) 'culture_id'" json:"culture_id"` //启用的语言ID，项目未配置语言时启用全部语言
+}
@@ -0,0 +1,263 @@
+// migration/versions.go
+package migration
+
+import (
+	"fmt"
+	"i18n-service/data/entity"
+	v1 "i18n-service/data/migration/v1"
+	"log"
+	"strings"
+
+	"xorm.io/xorm"
+)
+
+// migrations 所有版本的结构变更，新增的变更追加到末尾，已发布的变更不能修改。
+// entity 中的结构会随功能变化，迁移只能通过它获取表名，不能用它同步或读写列；
+// 修改 entity 的表结构时追加新的版本，用 DDL 完成变更
+var migrations = []Migration{
+	{Version: 1, Name: "create_tables", Up: createTables},
+	{Version: 2, Name: "unique_constraints", Up: uniqueConstraints},
+	{Version: 3, Name: "foreign_keys", Up: foreignKeys},
+	{Version: 4, Name: "project_tags", Up: projectTags},
+}
+
+// aliveColumn 软删除表中未删除的数据为 1、已删除的数据为 NULL 的生成列，
+// 与名称组成唯一索引，回收站中的数据不占用名称
+const aliveColumn = "alive"
+
+// aliveExpr 未删除的数据 deleted_at 为空或零值
+const aliveExpr = "IF(deleted_at > '1970-01-02', NULL, 1)"
+
+// createTables 按版本 1 的表结构快照创建数据表，已存在的表补充缺少的列和索引
+func createTables(db *xorm.Engine) error {
+	return db.Sync(
+		new(v1.CulturesResources),
+		new(v1.CulturesResourceTypes),
+		new(v1.CulturesResourceKeys),
+		new(v1.CulturesResourceLangs),
+		new(v1.CulturesResourcePlurals),
+		new(v1.CulturesResourceHistories),
+		new(v1.CulturesResourceTags),
+		new(v1.CulturesResourceKeyTags),
+		new(v1.CulturesProjects),
+		new(v1.CulturesProjectCultures),
+	)
+}
+
+// uniqueIndex 唯一索引
+type uniqueIndex struct {
+	bean    interface{}
+	name    string
+	columns []string
+	alive   bool // 是否只约束未删除的数据
+}
+
+// uniqueConstraints 为语言代码、项目内的资源类型及资源键名称、资源键的语言及复数形式添加唯一索引。
+// 已有重复数据时失败并列出重复的值，需要人工处理后重新执行
+func uniqueConstraints(db *xorm.Engine) error {
+	indexes := []uniqueIndex{
+		{bean: new(entity.CulturesResources), name: "uq_code", columns: []string{"code"}},
+		{bean: new(entity.CulturesResourceTypes), name: "uq_project_name", columns: []string{"project_id", "name"}, alive: true},
+		{bean: new(entity.CulturesResourceKeys), name: "uq_project_name", columns: []string{"project_id", "name"}, alive: true},
+		{bean: new(entity.CulturesResourceLangs), name: "uq_key_culture", columns: []string{"key_id", "culture_id"}},
+		{bean: new(entity.CulturesResourcePlurals), name: "uq_key_culture_category", columns: []string{"key_id", "culture_id", "category"}},
+	}
+	for _, idx := range indexes {
+		if err := addUniqueIndex(db, idx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addUniqueIndex 检查重复数据后添加唯一索引，已存在时跳过
+func addUniqueIndex(db *xorm.Engine, idx uniqueIndex) error {
+	table := db.TableName(idx.bean)
+	if err := checkDuplicates(db, table, idx); err != nil {
+		return err
+	}
+	columns := idx.columns
+	if idx.alive {
+		exists, err := columnExists(db, table, aliveColumn)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			sql := fmt.Sprintf("ALTER TABLE `%s` ADD COLUMN `%s` TINYINT AS (%s) VIRTUAL", table, aliveColumn, aliveExpr)
+			if _, err := db.Exec(sql); err != nil {
+				return err
+			}
+		}
+		columns = append(columns, aliveColumn)
+	}
+	exists, err := indexExists(db, table, idx.name)
+	if err != nil || exists {
+		return err
+	}
+	sql := fmt.Sprintf("ALTER TABLE `%s` ADD UNIQUE INDEX `%s` (`%s`)", table, idx.name, strings.Join(columns, "`, `"))
+	_, err = db.Exec(sql)
+	return err
+}
+
+// checkDuplicates 检查唯一索引的列是否已有重复数据
+func checkDuplicates(db *xorm.Engine, table string, idx uniqueIndex) error {
+	columns := "`" + strings.Join(idx.columns, "`, `") + "`"
+	where := ""
+	if idx.alive {
+		where = " WHERE " + aliveExpr + " = 1"
+	}
+	sql := fmt.Sprintf("SELECT CONCAT_WS('/', %s) AS value, COUNT(*) AS count FROM `%s`%s GROUP BY %s HAVING COUNT(*) > 1 LIMIT 20",
+		columns, table, where, columns)
+	rows, err := db.QueryString(sql)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(rows))
+	for _, v := range rows {
+		values = append(values, fmt.Sprintf("%s (%s rows)", v["value"], v["count"]))
+	}
+	return fmt.Errorf("duplicate %s in %s: %s", strings.Join(idx.columns, ", "), table, strings.Join(values, "; "))
+}
+
+// foreignKey 外键
+type foreignKey struct {
+	bean   interface{}
+	name   string
+	column string
+	ref    interface{}
+}
+
+// foreignKeys 为语言、复数形式、标签关联及项目语言添加外键。引用不存在的资源键、语言、标签或项目的数据无法通过接口访问，
+// 添加外键前删除。资源键的类型、历史记录及项目ID不添加外键：回收站中的资源键可以引用已永久删除的类型，
+// 历史记录需要保留已删除实体的记录，默认项目没有对应的项目记录
+func foreignKeys(db *xorm.Engine) error {
+	keys := []foreignKey{
+		{bean: new(entity.CulturesResourceLangs), name: "fk_langs_key", column: "key_id", ref: new(entity.CulturesResourceKeys)},
+		{bean: new(entity.CulturesResourceLangs), name: "fk_langs_culture", column: "culture_id", ref: new(entity.CulturesResources)},
+		{bean: new(entity.CulturesResourcePlurals), name: "fk_plurals_key", column: "key_id", ref: new(entity.CulturesResourceKeys)},
+		{bean: new(entity.CulturesResourcePlurals), name: "fk_plurals_culture", column: "culture_id", ref: new(entity.CulturesResources)},
+		{bean: new(entity.CulturesResourceKeyTags), name: "fk_key_tags_key", column: "key_id", ref: new(entity.CulturesResourceKeys)},
+		{bean: new(entity.CulturesResourceKeyTags), name: "fk_key_tags_tag", column: "tag_id", ref: new(entity.CulturesResourceTags)},
+		{bean: new(entity.CulturesProjectCultures), name: "fk_project_cultures_project", column: "project_id", ref: new(entity.CulturesProjects)},
+		{bean: new(entity.CulturesProjectCultures), name: "fk_project_cultures_culture", column: "culture_id", ref: new(entity.CulturesResources)},
+	}
+	for _, fk := range keys {
+		table, ref := db.TableName(fk.bean), db.TableName(fk.ref)
+		exists, err := constraintExists(db, table, fk.name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		sql := fmt.Sprintf("DELETE FROM `%s` WHERE `%s` NOT IN (SELECT `id` FROM `%s`)", table, fk.column, ref)
+		res, err := db.Exec(sql)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n > 0 {
+			log.Printf("deleted %d rows from %s referencing missing %s", n, table, ref)
+		}
+		sql = fmt.Sprintf("ALTER TABLE `%s` ADD CONSTRAINT `%s` FOREIGN KEY (`%s`) REFERENCES `%s` (`id`)", table, fk.name, fk.column, ref)
+		if _, err := db.Exec(sql); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// projectTags 标签改为按项目隔离：添加 project_id 列，已有标签属于默认项目，被其他项目的资源键使用的标签
+// 按项目复制一份并改为关联复制的标签；标签名称的唯一索引改为项目内唯一
+func projectTags(db *xorm.Engine) error {
+	table := db.TableName(new(entity.CulturesResourceTags))
+	keyTable := db.TableName(new(entity.CulturesResourceKeys))
+	keyTagTable := db.TableName(new(entity.CulturesResourceKeyTags))
+	exists, err := columnExists(db, table, "project_id")
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if _, err := db.Exec(fmt.Sprintf("ALTER TABLE `%s` ADD COLUMN `project_id` INT NOT NULL DEFAULT 0", table)); err != nil {
+			return err
+		}
+	}
+	// 名称的唯一索引由 xorm 按 unique 标记创建，删除后才能在不同项目中创建同名标签
+	unique := "UQE_" + table + "_name"
+	if exists, err = indexExists(db, table, unique); err != nil {
+		return err
+	}
+	if exists {
+		if _, err := db.Exec(fmt.Sprintf("ALTER TABLE `%s` DROP INDEX `%s`", table, unique)); err != nil {
+			return err
+		}
+	}
+	for _, column := range []string{"project_id", "name"} {
+		index := "IDX_" + table + "_" + column
+		exists, err := indexExists(db, table, index)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			if _, err := db.Exec(fmt.Sprintf("ALTER TABLE `%s` ADD INDEX `%s` (`%s`)", table, index, column)); err != nil {
+				return err
+			}
+		}
+	}
+	rows, err := db.QueryString(fmt.Sprintf("SELECT DISTINCT kt.tag_id, k.project_id, t.name FROM `%s` kt "+
+		"INNER JOIN `%s` k ON k.id = kt.key_id INNER JOIN `%s` t ON t.id = kt.tag_id WHERE k.project_id <> t.project_id",
+		keyTagTable, keyTable, table))
+	if err != nil {
+		return err
+	}
+	find := fmt.Sprintf("SELECT id FROM `%s` WHERE project_id = ? AND name = ?", table)
+	for _, v := range rows {
+		tags, err := db.QueryString(find, v["project_id"], v["name"])
+		if err != nil {
+			return err
+		}
+		if len(tags) == 0 {
+			if _, err := db.Exec(fmt.Sprintf("INSERT INTO `%s` (`project_id`, `name`) VALUES (?, ?)", table), v["project_id"], v["name"]); err != nil {
+				return err
+			}
+			if tags, err = db.QueryString(find, v["project_id"], v["name"]); err != nil {
+				return err
+			}
+		}
+		sql := fmt.Sprintf("UPDATE `%s` SET tag_id = ? WHERE tag_id = ? AND key_id IN (SELECT id FROM `%s` WHERE project_id = ?)", keyTagTable, keyTable)
+		if _, err := db.Exec(sql, tags[0]["id"], v["tag_id"], v["project_id"]); err != nil {
+			return err
+		}
+	}
+	if len(rows) > 0 {
+		log.Printf("copied %d tags used by keys of other projects", len(rows))
+	}
+	return addUniqueIndex(db, uniqueIndex{bean: new(entity.CulturesResourceTags), name: "uq_project_name", columns: []string{"project_id", "name"}})
+}
+
+// columnExists 当前数据库的表中是否存在列
+func columnExists(db *xorm.Engine, table, column string) (bool, error) {
+	return schemaExists(db, "COLUMNS", "table_name = ? AND column_name = ?", table, column)
+}
+
+// indexExists 当前数据库的表中是否存在索引
+func indexExists(db *xorm.Engine, table, index string) (bool, error) {
+	return schemaExists(db, "STATISTICS", "table_name = ? AND index_name = ?", table, index)
+}
+
+// constraintExists 当前数据库的表中是否存在约束
+func constraintExists(db *xorm.Engine, table, constraint string) (bool, error) {
+	return schemaExists(db, "TABLE_CONSTRAINTS", "table_name = ? AND constraint_name = ?", table, constraint)
+}
+
+// schemaExists 查询 information_schema 中当前数据库的记录是否存在
+func schemaExists(db *xorm.Engine, view, where string, args ...interface{}) (bool, error) {
+	sql := fmt.Sprintf("SELECT COUNT(*) FROM information_schema.%s WHERE table_schema = DATABASE() AND %s", view, where)
+	var count int64
+	if _, err := db.SQL(sql, args...).Get(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
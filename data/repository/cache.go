@@ -0,0 +1,497 @@
+// repository/cache.go
+package repository
+
+import (
+	"container/list"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"i18n-service/config"
+	"i18n-service/data/entity"
+	"strings"
+	"sync"
+	"time"
+)
+
+var cacheConfigKey = "I18nCache"
+
+// 默认缓存配置
+var defaultCacheConfig = config.CacheConfig{
+	TTL:        300,
+	MaxEntries: 64,
+	MaxBytes:   64 << 20,
+}
+
+// 每个缓存条目的估算固定开销(字节)
+const cacheEntryOverhead = 48
+
+// CultureSnapshot 单个语言的资源快照。
+// 快照发布后只读，缓存更新时会整体替换为新的快照。
+type CultureSnapshot struct {
+	Culture  entity.CulturesResources
+	Texts    map[int32]string            // keyID -> 文本
+	Plurals  map[int32]map[string]string // keyID -> 复数类别 -> 文本
+	Statuses map[int32]int32             // keyID -> 审核状态
+	Version  uint64                      // 内容版本，由所有文本及审核状态的哈希累加得到，随变更增量维护
+	size     int64
+}
+
+// CacheStats 缓存统计信息
+type CacheStats struct {
+	Hits      int64 // 命中次数
+	Misses    int64 // 未命中次数
+	Evictions int64 // 淘汰次数
+	Entries   int   // 当前缓存的语言快照数
+	Bytes     int64 // 当前缓存估算占用字节数
+}
+
+type cacheEntry struct {
+	snapshot *CultureSnapshot
+	expires  time.Time
+	elem     *list.Element
+}
+
+// ResourceCache 语言资源快照缓存，按语言代码缓存，LRU 淘汰。
+// 每次数据变更都会推进 generation，加载开始后发生变更的结果不会写入缓存。
+// 变更只使本进程的缓存失效，多副本部署时其他副本写入的修改依赖 TTL 过期后重新加载。
+type ResourceCache struct {
+	sync.Mutex
+	cfg         config.CacheConfig
+	generation  uint64
+	keys        map[int32]string // 资源键快照
+	keysSize    int64
+	keysExpires time.Time
+	cultures    []entity.CulturesResources // 语言列表快照
+	culturesExp time.Time
+	entries     map[string]*cacheEntry
+	lru         *list.List
+	bytes       int64
+	stats       CacheStats
+}
+
+// NewResourceCache 创建语言资源快照缓存
+func NewResourceCache(cfg config.CacheConfig) *ResourceCache {
+	return &ResourceCache{
+		cfg:     cfg,
+		entries: make(map[string]*cacheEntry),
+		lru:     list.New(),
+	}
+}
+
+// parseCacheConfig 解析缓存配置，为空或解析失败时使用默认配置
+func parseCacheConfig(str string) config.CacheConfig {
+	cfg := defaultCacheConfig
+	if str == "" {
+		return cfg
+	}
+	if err := json.Unmarshal([]byte(str), &cfg); err != nil {
+		return defaultCacheConfig
+	}
+	return cfg
+}
+
+// NewCultureSnapshot 由语言的翻译及复数形式构建快照
+func NewCultureSnapshot(culture entity.CulturesResources, langs []entity.CulturesResourceLangs, plurals []entity.CulturesResourcePlurals) *CultureSnapshot {
+	s := &CultureSnapshot{
+		Culture:  culture,
+		Texts:    make(map[int32]string, len(langs)),
+		Plurals:  make(map[int32]map[string]string),
+		Statuses: make(map[int32]int32, len(langs)),
+	}
+	for _, v := range langs {
+		s.Texts[v.KeyID] = v.Text
+		s.Version += entryHash(v.KeyID, v.Text)
+		s.size += int64(len(v.Text)) + cacheEntryOverhead
+		s.setStatus(v.KeyID, v.Status)
+	}
+	grouped := make(map[int32]map[string]string)
+	for _, v := range plurals {
+		if grouped[v.KeyID] == nil {
+			grouped[v.KeyID] = make(map[string]string)
+		}
+		grouped[v.KeyID][v.Category] = v.Text
+	}
+	for keyID, forms := range grouped {
+		s.setPlurals(keyID, forms)
+	}
+	return s
+}
+
+// setPlurals 设置资源键的复数形式，forms 为空时移除
+func (s *CultureSnapshot) setPlurals(keyID int32, forms map[string]string) {
+	if old, ok := s.Plurals[keyID]; ok {
+		s.Version -= pluralsHash(keyID, old)
+		s.size -= pluralsSize(old)
+		delete(s.Plurals, keyID)
+	}
+	if len(forms) == 0 {
+		return
+	}
+	s.Plurals[keyID] = forms
+	s.Version += pluralsHash(keyID, forms)
+	s.size += pluralsSize(forms)
+}
+
+// setStatus 设置资源键的审核状态
+func (s *CultureSnapshot) setStatus(keyID int32, status int32) {
+	if old, ok := s.Statuses[keyID]; ok {
+		s.Version -= statusHash(keyID, old)
+	}
+	s.Statuses[keyID] = status
+	s.Version += statusHash(keyID, status)
+}
+
+func statusHash(keyID int32, status int32) uint64 {
+	return entryHash(keyID, fmt.Sprintf("\x00status:%d", status))
+}
+
+func pluralsHash(keyID int32, forms map[string]string) uint64 {
+	var sum uint64
+	for category, text := range forms {
+		sum += entryHash(keyID, category+"\x00"+text)
+	}
+	return sum
+}
+
+func pluralsSize(forms map[string]string) int64 {
+	var size int64
+	for category, text := range forms {
+		size += int64(len(category)+len(text)) + cacheEntryOverhead
+	}
+	return size
+}
+
+// entryHash 计算单个条目的哈希，累加后与条目顺序无关
+func entryHash(id int32, text string) uint64 {
+	h := fnv.New64a()
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], uint32(id))
+	h.Write(buf[:])
+	h.Write([]byte(text))
+	return h.Sum64()
+}
+
+// BundleVersion 计算语言资源包的版本。
+// 版本由回退链上每个语言快照的内容版本、实际返回的资源键以及是否预览决定，内容不变时版本稳定，服务重启后也保持一致。
+func BundleVersion(snapshots []*CultureSnapshot, keys map[int32]string, preview bool) string {
+	var keysVersion uint64
+	for id, name := range keys {
+		keysVersion += entryHash(id, name)
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%x", keysVersion)
+	if preview {
+		fmt.Fprint(h, "|preview")
+	}
+	for _, s := range snapshots {
+		fmt.Fprintf(h, "|%d:%s:%x", s.Culture.ID, s.Culture.Code, s.Version)
+	}
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// clone 复制快照，用于写时复制
+func (s *CultureSnapshot) clone() *CultureSnapshot {
+	c := &CultureSnapshot{
+		Culture:  s.Culture,
+		Texts:    make(map[int32]string, len(s.Texts)),
+		Plurals:  make(map[int32]map[string]string, len(s.Plurals)),
+		Statuses: make(map[int32]int32, len(s.Statuses)),
+		Version:  s.Version,
+		size:     s.size,
+	}
+	for k, v := range s.Texts {
+		c.Texts[k] = v
+	}
+	for k, v := range s.Statuses {
+		c.Statuses[k] = v
+	}
+	// 复数形式整体替换，内层 map 可以共享
+	for k, v := range s.Plurals {
+		c.Plurals[k] = v
+	}
+	return c
+}
+
+func keysSize(keys map[int32]string) int64 {
+	var size int64
+	for _, v := range keys {
+		size += int64(len(v)) + cacheEntryOverhead
+	}
+	return size
+}
+
+func (c *ResourceCache) expiresAt() time.Time {
+	if c.cfg.TTL <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(c.cfg.TTL) * time.Second)
+}
+
+func expired(t time.Time) bool {
+	return !t.IsZero() && time.Now().After(t)
+}
+
+// setConfig 更新缓存配置并按新限制淘汰
+func (c *ResourceCache) setConfig(cfg config.CacheConfig) {
+	c.Lock()
+	defer c.Unlock()
+	c.cfg = cfg
+	c.evict()
+}
+
+// CurrentGeneration 返回当前数据版本，加载数据前调用
+func (c *ResourceCache) CurrentGeneration() uint64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.generation
+}
+
+// cultureKey 快照的缓存键。MySQL 按不区分大小写的排序规则匹配语言代码，
+// en-us 与 en-US 查询到同一个语言，缓存键统一为小写
+func cultureKey(code string) string {
+	return strings.ToLower(code)
+}
+
+// GetCulture 根据语言代码获取快照
+func (c *ResourceCache) GetCulture(code string) (*CultureSnapshot, bool) {
+	c.Lock()
+	defer c.Unlock()
+	code = cultureKey(code)
+	e, ok := c.entries[code]
+	if !ok || expired(e.expires) {
+		if ok {
+			c.remove(code, e)
+		}
+		c.stats.Misses++
+		return nil, false
+	}
+	c.lru.MoveToFront(e.elem)
+	c.stats.Hits++
+	return e.snapshot, true
+}
+
+// PutCulture 写入快照，generation 已变化时丢弃
+func (c *ResourceCache) PutCulture(generation uint64, s *CultureSnapshot) {
+	c.Lock()
+	defer c.Unlock()
+	if generation != c.generation {
+		return
+	}
+	code := cultureKey(s.Culture.Code)
+	if e, ok := c.entries[code]; ok {
+		c.remove(code, e)
+	}
+	e := &cacheEntry{snapshot: s, expires: c.expiresAt()}
+	e.elem = c.lru.PushFront(code)
+	c.entries[code] = e
+	c.bytes += s.size
+	c.evict()
+}
+
+// getKeys 获取资源键快照
+func (c *ResourceCache) getKeys() (map[int32]string, bool) {
+	c.Lock()
+	defer c.Unlock()
+	if c.keys == nil || expired(c.keysExpires) {
+		c.dropKeys()
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	return c.keys, true
+}
+
+// putKeys 写入资源键快照，generation 已变化时丢弃
+func (c *ResourceCache) putKeys(generation uint64, keys map[int32]string) {
+	c.Lock()
+	defer c.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.dropKeys()
+	c.keys = keys
+	c.keysSize = keysSize(keys)
+	c.keysExpires = c.expiresAt()
+	c.bytes += c.keysSize
+	c.evict()
+}
+
+// getCultures 获取语言列表快照
+func (c *ResourceCache) getCultures() ([]entity.CulturesResources, bool) {
+	c.Lock()
+	defer c.Unlock()
+	if c.cultures == nil || expired(c.culturesExp) {
+		c.cultures = nil
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	return c.cultures, true
+}
+
+// putCultures 写入语言列表快照，generation 已变化时丢弃
+func (c *ResourceCache) putCultures(generation uint64, cultures []entity.CulturesResources) {
+	c.Lock()
+	defer c.Unlock()
+	if generation != c.generation {
+		return
+	}
+	if cultures == nil {
+		cultures = []entity.CulturesResources{}
+	}
+	c.cultures = cultures
+	c.culturesExp = c.expiresAt()
+}
+
+// PatchText 更新某个语言快照中的单个文本及其审核状态，plurals 为 nil 时复数形式保持不变
+func (c *ResourceCache) PatchText(cultureID, keyID int32, text string, status int32, plurals map[string]string) {
+	c.Lock()
+	defer c.Unlock()
+	c.generation++
+	for _, e := range c.entries {
+		if e.snapshot.Culture.ID != cultureID {
+			continue
+		}
+		s := e.snapshot.clone()
+		if old, ok := s.Texts[keyID]; ok {
+			s.Version -= entryHash(keyID, old)
+			s.size -= int64(len(old)) + cacheEntryOverhead
+		}
+		s.Texts[keyID] = text
+		s.Version += entryHash(keyID, text)
+		s.size += int64(len(text)) + cacheEntryOverhead
+		s.setStatus(keyID, status)
+		if plurals != nil {
+			s.setPlurals(keyID, plurals)
+		}
+		c.bytes += s.size - e.snapshot.size
+		e.snapshot = s
+	}
+	c.evict()
+}
+
+// patchStatus 更新某个语言快照中单个资源键的审核状态
+func (c *ResourceCache) patchStatus(cultureID, keyID int32, status int32) {
+	c.Lock()
+	defer c.Unlock()
+	c.generation++
+	for _, e := range c.entries {
+		if e.snapshot.Culture.ID != cultureID {
+			continue
+		}
+		s := e.snapshot.clone()
+		s.setStatus(keyID, status)
+		e.snapshot = s
+	}
+}
+
+// putKey 在资源键快照中添加或更新资源键
+func (c *ResourceCache) putKey(keyID int32, name string) {
+	c.Lock()
+	defer c.Unlock()
+	c.generation++
+	if c.keys == nil {
+		return
+	}
+	keys := make(map[int32]string, len(c.keys)+1)
+	for k, v := range c.keys {
+		keys[k] = v
+	}
+	keys[keyID] = name
+	c.bytes -= c.keysSize
+	c.keys = keys
+	c.keysSize = keysSize(keys)
+	c.bytes += c.keysSize
+	c.evict()
+}
+
+// removeKey 从所有快照中移除资源键
+func (c *ResourceCache) removeKey(keyID int32) {
+	c.Lock()
+	defer c.Unlock()
+	c.generation++
+	if c.keys != nil {
+		if _, ok := c.keys[keyID]; ok {
+			keys := make(map[int32]string, len(c.keys))
+			for k, v := range c.keys {
+				if k != keyID {
+					keys[k] = v
+				}
+			}
+			c.bytes -= c.keysSize
+			c.keys = keys
+			c.keysSize = keysSize(keys)
+			c.bytes += c.keysSize
+		}
+	}
+	for _, e := range c.entries {
+		old, ok := e.snapshot.Texts[keyID]
+		_, hasPlurals := e.snapshot.Plurals[keyID]
+		if !ok && !hasPlurals {
+			continue
+		}
+		s := e.snapshot.clone()
+		if ok {
+			delete(s.Texts, keyID)
+			s.Version -= entryHash(keyID, old)
+			s.size -= int64(len(old)) + cacheEntryOverhead
+		}
+		if status, ok := s.Statuses[keyID]; ok {
+			delete(s.Statuses, keyID)
+			s.Version -= statusHash(keyID, status)
+		}
+		s.setPlurals(keyID, nil)
+		c.bytes += s.size - e.snapshot.size
+		e.snapshot = s
+	}
+}
+
+// Invalidate 清空所有快照
+func (c *ResourceCache) Invalidate() {
+	c.Lock()
+	defer c.Unlock()
+	c.generation++
+	c.dropKeys()
+	c.cultures = nil
+	for code, e := range c.entries {
+		c.remove(code, e)
+	}
+}
+
+// Stats 获取缓存统计信息
+func (c *ResourceCache) Stats() CacheStats {
+	c.Lock()
+	defer c.Unlock()
+	stats := c.stats
+	stats.Entries = len(c.entries)
+	stats.Bytes = c.bytes
+	return stats
+}
+
+func (c *ResourceCache) dropKeys() {
+	c.bytes -= c.keysSize
+	c.keys = nil
+	c.keysSize = 0
+	c.keysExpires = time.Time{}
+}
+
+func (c *ResourceCache) remove(code string, e *cacheEntry) {
+	c.lru.Remove(e.elem)
+	delete(c.entries, code)
+	c.bytes -= e.snapshot.size
+}
+
+// evict 按条目数和内存上限淘汰最久未使用的快照
+func (c *ResourceCache) evict() {
+	for c.lru.Len() > 0 {
+		overEntries := c.cfg.MaxEntries > 0 && c.lru.Len() > c.cfg.MaxEntries
+		overBytes := c.cfg.MaxBytes > 0 && c.bytes > c.cfg.MaxBytes
+		if !overEntries && !overBytes {
+			return
+		}
+		code := c.lru.Back().Value.(string)
+		c.remove(code, c.entries[code])
+		c.stats.Evictions++
+	}
+}
@@ -0,0 +1,88 @@
+// repository/culture.go
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"i18n-service/data/entity"
+
+	"golang.org/x/text/language"
+)
+
+// 语言状态，取值与 proto.CultureStatuses 一致
+const (
+	CultureEnabled  int32 = iota // 启用
+	CultureBeta                  // 测试，不参与语言协商
+	CultureDisabled              // 停用，只在预览时提供
+)
+
+// 文字方向
+const (
+	DirectionLTR = "ltr"
+	DirectionRTL = "rtl"
+)
+
+// ErrInvalidCulture 语言属性不合法
+var ErrInvalidCulture = errors.New("invalid culture")
+
+// rtlScripts 从右向左书写的 ISO 15924 书写系统
+var rtlScripts = map[string]bool{
+	"Adlm": true, "Arab": true, "Hebr": true, "Mand": true, "Nkoo": true,
+	"Rohg": true, "Samr": true, "Syrc": true, "Thaa": true,
+}
+
+// textDirection 根据语言代码的书写系统推断文字方向，未指定书写系统时使用 CLDR 中该语言最可能的书写系统，
+// 例如 ar、fa、az-Arab 为 rtl，az 为 ltr
+func textDirection(code string) string {
+	tag, err := language.Parse(code)
+	if err != nil {
+		return DirectionLTR
+	}
+	script, _ := tag.Script()
+	if rtlScripts[script.String()] {
+		return DirectionRTL
+	}
+	return DirectionLTR
+}
+
+// normalizeCulture 校验语言属性，未指定文字方向时根据语言代码推断。
+// isDefault 为语言是否为默认语言，更新时包括已保存的值，更新不会清除默认语言标记
+func normalizeCulture(culture *entity.CulturesResources, isDefault bool) error {
+	if culture.Direction == "" {
+		culture.Direction = textDirection(culture.Code)
+	}
+	if culture.Direction != DirectionLTR && culture.Direction != DirectionRTL {
+		return fmt.Errorf("%w: direction must be ltr or rtl, got %q", ErrInvalidCulture, culture.Direction)
+	}
+	if culture.Status < CultureEnabled || culture.Status > CultureDisabled {
+		return fmt.Errorf("%w: unknown status %d", ErrInvalidCulture, culture.Status)
+	}
+	if culture.PluralRule != "" {
+		if _, err := language.Parse(culture.PluralRule); err != nil {
+			return fmt.Errorf("%w: plural rule %q: %v", ErrInvalidCulture, culture.PluralRule, err)
+		}
+	}
+	if culture.ID > 0 && culture.ParentID == culture.ID {
+		return fmt.Errorf("%w: culture cannot fall back to itself", ErrInvalidCulture)
+	}
+	if isDefault && culture.Status == CultureDisabled {
+		return fmt.Errorf("%w: default culture cannot be disabled", ErrInvalidCulture)
+	}
+	return nil
+}
+
+// CultureDirection 获取语言的文字方向，未配置时根据语言代码推断
+func CultureDirection(culture entity.CulturesResources) string {
+	if culture.Direction != "" {
+		return culture.Direction
+	}
+	return textDirection(culture.Code)
+}
+
+// PluralLocale 获取语言的复数规则使用的语言，未配置时为语言代码本身
+func PluralLocale(culture entity.CulturesResources) string {
+	if culture.PluralRule != "" {
+		return culture.PluralRule
+	}
+	return culture.Code
+}
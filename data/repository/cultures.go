@@ -2,22 +2,28 @@
 package repository
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"i18n-service/config"
 	"i18n-service/data/entity"
+	"i18n-service/data/migration"
 	"log"
 	"reflect"
-	"sync"
+	"time"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/go-sql-driver/mysql"
+	"xorm.io/builder"
 	"xorm.io/xorm"
 )
 
+// CulturesRepositoryImpl 项目范围的仓库，所有资源类型、资源键及其语言的查询都限定在 project 内
 type CulturesRepositoryImpl struct {
-	sync.RWMutex
-	db *xorm.Engine
+	*repositoryStore
+	project int32          // 项目ID
+	cache   *ResourceCache // 项目的资源缓存
+	feed    *ChangeFeed    // 项目的资源变更流
 }
 
 type CulturesRepository interface {
@@ -43,10 +49,10 @@ type CulturesRepository interface {
 	// 添加或更新语言
 	// 参数：
 	//
-	// 	culture: 语言
+	// 	culture: 语言，未指定文字方向时根据语言代码推断
 	// 返回值：
 	//
-	// 	error: 错误信息
+	// 	error: 错误信息，语言属性不合法时返回 ErrInvalidCulture
 	AddOrUpdateCultures(culture entity.CulturesResources) error
 	// 添加或更新资源类型
 	// 参数：
@@ -55,15 +61,16 @@ type CulturesRepository interface {
 	// 返回值：
 	//
 	// 	error: 错误信息
-	AddOrUpdateCulturesResourceType(data entity.CulturesResourceTypes) error
-	// 删除资源类型
+	AddOrUpdateCulturesResourceType(ctx context.Context, data entity.CulturesResourceTypes) error
+	// 删除资源类型（软删除）
 	// 参数：
 	//
 	// 	id: 资源类型ID
+	// 	reassignTo: 仍有资源键引用该类型时，将这些资源键改为该类型；为 0 时拒绝删除
 	// 	返回值：
 	//
-	// 	error: 错误信息
-	DeleteCulturesResourceType(id int64) error
+	// 	error: 错误信息，仍被引用且未指定 reassignTo 时返回 ErrTypeInUse
+	DeleteCulturesResourceType(ctx context.Context, id int64, reassignTo int64) error
 	// 添加或更新资源键
 	// 参数：
 	//
@@ -72,15 +79,15 @@ type CulturesRepository interface {
 	//
 	// 	*entity.CulturesResourceKeys: 资源键
 	// 	error: 错误信息
-	AddOrUpdateCulturesResourceKey(data entity.CulturesResourceKeys) (*entity.CulturesResourceKeys, error)
+	AddOrUpdateCulturesResourceKey(ctx context.Context, data entity.CulturesResourceKeys) (*entity.CulturesResourceKeys, error)
 	// 添加或更新资源语言
 	// 参数：
 	//
 	// 	data: 资源语言
 	// 	返回值：
 	//
-	// 	error: 错误信息
-	AddOrUpdateCulturesResourceLang(data entity.CulturesResourceLangs) error
+	// 	error: 错误信息，超过资源键的最大字符数时返回 ErrTextTooLong，占位符与默认语言的翻译不一致时返回 ErrPlaceholderMismatch
+	AddOrUpdateCulturesResourceLang(ctx context.Context, data entity.CulturesResourceLangs) error
 	// 获取资源语言分页列表
 	// 参数：
 	//
@@ -88,12 +95,14 @@ type CulturesRepository interface {
 	// 	size: 页记录数
 	// 	cultureId: 语言ID
 	// 	findKey: 查询条件
+	// 	statuses: 审核状态，为空时不过滤
+	// 	tags: 资源键的标签名称，为空时不过滤
 	// 	返回值：
 	//
 	// 	[]entity.CulturesResourceLangs: 资源语言列表
 	// 	total: 总记录数
 	// 	error: 错误信息
-	GetCulturesResourceLangPager(index int, size int, cultureId int, findKey string) ([]entity.CulturesResourceLangs, int64, error)
+	GetCulturesResourceLangPager(index int, size int, cultureId int, findKey string, statuses []int32, tags []string) ([]entity.CulturesResourceLangs, int64, error)
 	// 根据ID获取资源类型列表
 	// 参数：
 	//
@@ -109,12 +118,13 @@ type CulturesRepository interface {
 	// 	index: 页码
 	// 	limit: 页记录数
 	// 	text: 查询条件
+	// 	tags: 标签名称，带有任意一个标签的资源键匹配，为空时不过滤
 	// 	返回值：
 	//
 	// 	[]entity.CulturesResourceKeys: 资源键列表
 	// 	total: 总记录数
 	// 	error: 错误信息
-	GetCulturesResourceKeyPager(index int, limit int, text string) ([]entity.CulturesResourceKeys, int64, error)
+	GetCulturesResourceKeyPager(index int, limit int, text string, tags []string) ([]entity.CulturesResourceKeys, int64, error)
 	// 根据ID获取资源键列表
 	// 参数：
 	//
@@ -138,16 +148,16 @@ type CulturesRepository interface {
 	// 	cultureLang: 资源语言列表
 	// 返回值：
 	//
-	// 	error: 错误信息
-	AddCulturesResourceLangs(key string, tid int32, cultureLang []entity.CulturesResourceLangs) error
-	// 删除资源键
+	// 	error: 错误信息，超过资源键的最大字符数时返回 ErrTextTooLong，占位符与默认语言的翻译不一致时返回 ErrPlaceholderMismatch
+	AddCulturesResourceLangs(ctx context.Context, key string, tid int32, cultureLang []entity.CulturesResourceLangs) error
+	// 删除资源键（软删除，其语言一并移入回收站）
 	// 参数：
 	//
 	// 	id: 资源键ID
 	// 返回值：
 	//
 	// 	error: 错误信息
-	DeleteCulturesResourceKey(id int32) error
+	DeleteCulturesResourceKey(ctx context.Context, id int32) error
 	// 获取资源类型分页列表
 	// 参数：
 	//
@@ -169,37 +179,351 @@ type CulturesRepository interface {
 	// 	[]entity.CulturesResourceLangs: 资源语言列表
 	// 	error: 错误信息
 	GetCulturesResourceLangByKeyId(keyId int) ([]entity.CulturesResourceLangs, error)
+	// 根据 Code 获取语言资源快照（读穿透缓存）
+	// 参数：
+	//
+	// 	code: 语言代码
+	// 返回值：
+	//
+	// 	*CultureSnapshot: 语言资源快照
+	// 	error: 错误信息
+	GetCultureSnapshot(code string) (*CultureSnapshot, error)
+	// 获取缓存统计信息
+	// 返回值：
+	//
+	// 	CacheStats: 缓存统计信息
+	GetCacheStats() CacheStats
+	// 订阅资源变更
+	// 返回值：
+	//
+	// 	*ChangeSubscription: 变更订阅，使用完毕后需调用 Close
+	SubscribeResourceChanges() *ChangeSubscription
+	// 获取指定序号之后的资源变更
+	// 参数：
+	//
+	// 	seq: 变更序号
+	// 返回值：
+	//
+	// 	[]ResourceChange: 变更列表
+	// 	bool: 缓冲区已不包含所需变更时为 false
+	GetResourceChangesSince(seq uint64) ([]ResourceChange, bool)
+	// 获取语言的回退链
+	// 参数：
+	//
+	// 	code: 语言代码
+	// 返回值：
+	//
+	// 	[]entity.CulturesResources: 回退链，第一个为语言本身，最后为默认语言
+	// 	error: 错误信息
+	GetCultureFallbackChain(code string) ([]entity.CulturesResources, error)
+	// 根据过滤条件获取资源键列表
+	// 参数：
+	//
+	// 	filter: 过滤条件
+	// 返回值：
+	//
+	// 	map[int32]string: 资源键列表
+	// 	error: 错误信息
+	GetCulturesResourceKeysByFilter(filter ResourceKeyFilter) (map[int32]string, error)
+	// 根据名称获取资源键列表
+	// 参数：
+	//
+	// 	names: 资源键名称列表
+	// 返回值：
+	//
+	// 	map[int32]string: 资源键列表
+	// 	error: 错误信息
+	GetCulturesResourceKeysByNames(names []string) (map[int32]string, error)
+	// 获取指定语言和资源键的资源语言列表
+	// 参数：
+	//
+	// 	cultureIds: 语言ID列表
+	// 	keyIds: 资源键ID列表
+	// 返回值：
+	//
+	// 	[]entity.CulturesResourceLangs: 资源语言列表
+	// 	error: 错误信息
+	GetCulturesResourceLangsByKeyIds(cultureIds []int32, keyIds []int32) ([]entity.CulturesResourceLangs, error)
+	// 获取指定语言和资源键的复数形式列表
+	// 参数：
+	//
+	// 	cultureIds: 语言ID列表
+	// 	keyIds: 资源键ID列表
+	// 返回值：
+	//
+	// 	[]entity.CulturesResourcePlurals: 复数形式列表
+	// 	error: 错误信息
+	GetCulturesResourcePluralsByKeyIds(cultureIds []int32, keyIds []int32) ([]entity.CulturesResourcePlurals, error)
+	// 流转资源语言的审核状态
+	// 参数：
+	//
+	// 	ids: 资源语言ID列表
+	// 	status: 目标审核状态
+	// 	reviewer: 审核人，审核通过或驳回时记录
+	// 返回值：
+	//
+	// 	error: 错误信息，任一资源语言不允许流转时返回 ErrInvalidTransition
+	TransitionCulturesResourceLangs(ctx context.Context, ids []int64, status int32, reviewer string) error
+	// 获取资源历史分页列表
+	// 参数：
+	//
+	// 	index: 页码
+	// 	size: 页记录数
+	// 	filter: 查询条件
+	// 返回值：
+	//
+	// 	[]entity.CulturesResourceHistories: 历史记录列表，按时间倒序
+	// 	total: 总记录数
+	// 	error: 错误信息
+	GetCulturesResourceHistoryPager(index int, size int, filter HistoryFilter) ([]entity.CulturesResourceHistories, int64, error)
+	// 还原资源历史版本
+	// 参数：
+	//
+	// 	id: 历史记录ID
+	// 返回值：
+	//
+	// 	error: 错误信息
+	RestoreCulturesResourceRevision(ctx context.Context, id int64) error
+	// 获取回收站中的资源键分页列表
+	// 参数：
+	//
+	// 	index: 页码
+	// 	size: 页记录数
+	// 返回值：
+	//
+	// 	[]entity.CulturesResourceKeys: 资源键列表，按删除时间倒序
+	// 	total: 总记录数
+	// 	error: 错误信息
+	GetDeletedCulturesResourceKeyPager(index int, size int) ([]entity.CulturesResourceKeys, int64, error)
+	// 获取回收站中的资源类型分页列表
+	// 参数：
+	//
+	// 	index: 页码
+	// 	size: 页记录数
+	// 返回值：
+	//
+	// 	[]entity.CulturesResourceTypes: 资源类型列表，按删除时间倒序
+	// 	total: 总记录数
+	// 	error: 错误信息
+	GetDeletedCulturesResourceTypePager(index int, size int) ([]entity.CulturesResourceTypes, int64, error)
+	// 从回收站还原资源键及其语言
+	// 参数：
+	//
+	// 	id: 资源键ID
+	// 返回值：
+	//
+	// 	error: 错误信息
+	RestoreCulturesResourceKey(ctx context.Context, id int32) error
+	// 从回收站还原资源类型
+	// 参数：
+	//
+	// 	id: 资源类型ID
+	// 返回值：
+	//
+	// 	error: 错误信息
+	RestoreCulturesResourceType(ctx context.Context, id int64) error
+	// 获取回收站数据的保留时长
+	// 返回值：
+	//
+	// 	time.Duration: 保留时长，超过后永久删除，0 表示不清理
+	GetTrashRetention() time.Duration
+	// 获取标签分页列表
+	// 参数：
+	//
+	// 	index: 页码
+	// 	size: 页记录数
+	// 	text: 查询条件
+	// 返回值：
+	//
+	// 	[]entity.CulturesResourceTags: 标签列表
+	// 	total: 总记录数
+	// 	error: 错误信息
+	GetCulturesResourceTagPager(index int, size int, text string) ([]entity.CulturesResourceTags, int64, error)
+	// 添加或更新标签
+	// 参数：
+	//
+	// 	data: 标签
+	// 返回值：
+	//
+	// 	error: 错误信息
+	AddOrUpdateCulturesResourceTag(data entity.CulturesResourceTags) error
+	// 删除标签及其与资源键的关联
+	// 参数：
+	//
+	// 	id: 标签ID
+	// 返回值：
+	//
+	// 	error: 错误信息
+	DeleteCulturesResourceTag(id int32) error
+	// 为资源键添加或移除标签，添加的标签不存在时自动创建
+	// 参数：
+	//
+	// 	keyIds: 资源键ID列表
+	// 	add: 添加的标签名称
+	// 	remove: 移除的标签名称
+	// 返回值：
+	//
+	// 	error: 错误信息
+	TagCulturesResourceKeys(keyIds []int32, add []string, remove []string) error
+	// 获取资源键的标签
+	// 参数：
+	//
+	// 	keyIds: 资源键ID列表
+	// 返回值：
+	//
+	// 	map[int32][]string: 资源键ID -> 标签名称列表
+	// 	error: 错误信息
+	GetCulturesResourceKeyTags(keyIds []int32) (map[int32][]string, error)
+	// 获取指定项目范围的仓库
+	// 参数：
+	//
+	// 	projectID: 项目ID，DefaultProjectID 为默认项目
+	// 返回值：
+	//
+	// 	CulturesRepository: 项目范围的仓库，缓存和变更流按项目隔离
+	WithProject(projectID int32) CulturesRepository
+	// 根据代码获取项目
+	// 参数：
+	//
+	// 	code: 项目代码
+	// 返回值：
+	//
+	// 	*entity.CulturesProjects: 项目
+	// 	error: 错误信息，项目不存在时返回 ErrProjectNotFound
+	GetProjectByCode(code string) (*entity.CulturesProjects, error)
+	// 获取项目分页列表
+	// 参数：
+	//
+	// 	index: 页码
+	// 	size: 页记录数
+	// 	text: 查询条件
+	// 返回值：
+	//
+	// 	[]entity.CulturesProjects: 项目列表
+	// 	total: 总记录数
+	// 	error: 错误信息
+	GetCulturesProjectPager(index int, size int, text string) ([]entity.CulturesProjects, int64, error)
+	// 获取项目启用的语言
+	// 参数：
+	//
+	// 	projectIds: 项目ID列表
+	// 返回值：
+	//
+	// 	map[int32][]int32: 项目ID -> 语言ID列表，未配置语言的项目启用全部语言
+	// 	error: 错误信息
+	GetCulturesProjectCultures(projectIds []int32) (map[int32][]int32, error)
+	// 添加或更新项目
+	// 参数：
+	//
+	// 	data: 项目
+	// 	cultureIds: 项目启用的语言ID，为空时启用全部语言
+	// 返回值：
+	//
+	// 	*entity.CulturesProjects: 项目
+	// 	error: 错误信息
+	AddOrUpdateCulturesProject(data entity.CulturesProjects, cultureIds []int32) (*entity.CulturesProjects, error)
+	// 删除项目及其启用的语言和标签
+	// 参数：
+	//
+	// 	id: 项目ID
+	// 返回值：
+	//
+	// 	error: 错误信息，项目下仍有资源类型或资源键时返回 ErrProjectInUse
+	DeleteCulturesProject(id int32) error
+	// 获取符合条件的资源键，按名称排序
+	// 参数：
+	//
+	// 	filter: 过滤条件，为空时返回项目的所有资源键
+	// 返回值：
+	//
+	// 	[]entity.CulturesResourceKeys: 资源键列表
+	// 	error: 错误信息
+	GetCulturesResourceKeyList(filter ResourceKeyFilter) ([]entity.CulturesResourceKeys, error)
+	// 导入资源键及其翻译，所有资源项在同一事务中导入
+	// 参数：
+	//
+	// 	cultureID: 翻译的语言ID
+	// 	typeID: 未指定资源类型的新资源键使用的资源类型ID
+	// 	entries: 资源项
+	// 返回值：
+	//
+	// 	*ImportResult: 新增、修改及跳过的数量
+	// 	error: 错误信息，翻译超长或占位符不一致时返回 ErrTextTooLong、ErrPlaceholderMismatch
+	ImportCulturesResources(ctx context.Context, cultureID int32, typeID int32, entries []ImportEntry) (*ImportResult, error)
+	// 停止回收站的定期清理并关闭数据库连接，所有项目共享，关闭后仓库不可再使用
+	// 返回值：
+	//
+	// 	error: 错误信息
+	Close() error
 }
 
 // 确保 CulturesRepository 实现了接口 (编译时检查)
 var _ CulturesRepository = (*CulturesRepositoryImpl)(nil)
 
 func NewCulturesRepository(configManager *config.ConfigManager) (*CulturesRepositoryImpl, error) {
-	str := configManager.GetValue(dbConfigKey)
-	if str == "" {
-		return nil, errors.New("database config is empty")
-	}
-	db, err := createEngine(str)
+	db, err := configEngine(configManager)
 	if err != nil {
 		return nil, err
 	}
-	obj := &CulturesRepositoryImpl{
-		db: db,
+	store := &repositoryStore{
+		db:          db,
+		cacheConfig: parseCacheConfig(configManager.GetValue(cacheConfigKey)),
+		trash:       parseTrashConfig(configManager.GetValue(trashConfigKey)),
+		scopes:      make(map[int32]*projectScope),
+		stop:        make(chan struct{}),
 	}
+	obj := (&CulturesRepositoryImpl{repositoryStore: store}).WithProject(DefaultProjectID).(*CulturesRepositoryImpl)
 	configManager.RegisterListener("application", dbConfigKey, obj)
-
-	// 自动建表
-	// if err := r.db.Sync2(new(entity.CulturesResources),
-	// 	new(entity.CulturesResourceTypes),
-	// 	new(entity.CulturesResourceKeys),
-	// 	new(entity.CulturesResourceLangs)); err != nil {
-	// 	panic(err)
-	// }
+	configManager.RegisterListener("application", cacheConfigKey, obj)
+	configManager.RegisterListener("application", trashConfigKey, obj)
+	go obj.purgeLoop()
 	return obj, nil
 }
 
+// 执行数据库结构迁移，建表及升级由 migration 包中的版本化迁移完成
+// 返回值：
+//
+//	[]migration.Migration: 本次执行的迁移
+//	error: 错误信息
+func Migrate(configManager *config.ConfigManager) ([]migration.Migration, error) {
+	db, err := configEngine(configManager)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	return migration.Migrate(db)
+}
+
+// 获取数据库结构迁移的执行状态
+func MigrationStatus(configManager *config.ConfigManager) ([]migration.Status, error) {
+	db, err := configEngine(configManager)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	return migration.GetStatus(db)
+}
+
 var dbConfigKey = "I18ndb"
 
+// configEngine 根据配置中心的数据库配置创建数据库引擎
+func configEngine(configManager *config.ConfigManager) (*xorm.Engine, error) {
+	str := configManager.GetValue(dbConfigKey)
+	if str == "" {
+		return nil, errors.New("database config is empty")
+	}
+	return createEngine(str)
+}
+
+// uniqueError 违反唯一索引时返回与写入前重复检查一致的错误，并发写入时重复检查可能都通过
+func uniqueError(err error, message string) error {
+	var e *mysql.MySQLError
+	if errors.As(err, &e) && e.Number == 1062 {
+		return errors.New(message)
+	}
+	return err
+}
+
 func createEngine(str string) (*xorm.Engine, error) {
 	var cfg config.MySQLConfig
 	err := json.Unmarshal([]byte(str), &cfg)
@@ -220,18 +544,48 @@ func createEngine(str string) (*xorm.Engine, error) {
 func (r *CulturesRepositoryImpl) OnConfigUpdate(namespace string, key string, newValue interface{}) {
 	r.Lock()
 	defer r.Unlock()
-	if v, ok := newValue.(string); ok {
+	v, ok := newValue.(string)
+	if !ok {
+		fmt.Printf("config Invalid type for key: %s, expected string, got: %v\n", key, reflect.TypeOf(newValue))
+		return
+	}
+	switch key {
+	case cacheConfigKey:
+		fmt.Printf("cache config updated to: %+v\n", v)
+		r.cacheConfig = parseCacheConfig(v)
+		r.eachScope(func(s *projectScope) { s.cache.setConfig(r.cacheConfig) })
+	case trashConfigKey:
+		fmt.Printf("trash config updated to: %+v\n", v)
+		r.trash = parseTrashConfig(v)
+	default:
 		fmt.Printf("database config updated to: %+v\n", v)
 		r.db, _ = createEngine(v)
-	} else {
-		fmt.Printf("database config Invalid type for key: %s, expected string, got: %v\n", key, reflect.TypeOf(newValue))
+		r.projects = nil
+		r.projectsGen++
+		r.eachScope(func(s *projectScope) { s.cache.Invalidate() })
 	}
 }
 
-// 获取支持的语言列表
+// 获取项目启用的语言列表，项目未配置语言时为全部语言（读穿透缓存，返回值只读）
 func (r *CulturesRepositoryImpl) GetCultures() ([]entity.CulturesResources, error) {
+	if cultures, ok := r.cache.getCultures(); ok {
+		return cultures, nil
+	}
+	generation := r.cache.CurrentGeneration()
+	ids, err := r.projectCultureIds()
+	if err != nil {
+		return nil, err
+	}
 	var cultures []entity.CulturesResources
-	err := r.db.Find(&cultures)
+	sess := r.db.NewSession()
+	defer sess.Close()
+	if len(ids) > 0 {
+		sess.In("id", ids)
+	}
+	err = sess.Find(&cultures)
+	if err == nil {
+		r.cache.putCultures(generation, cultures)
+	}
 	return cultures, err
 }
 
@@ -248,12 +602,27 @@ func (r *CulturesRepositoryImpl) GetResourcesByCode(code string) ([]entity.Cultu
 		return nil, err
 	}
 	var langs []entity.CulturesResourceLangs
-	err = r.db.Where("culture_id = ?", culture.ID).Find(&langs)
+	err = r.db.Where("culture_id = ?", culture.ID).And(r.projectKeyCondition("key_id")).Find(&langs)
 	return langs, err
 }
 
 // 添加或更新语言
 func (r *CulturesRepositoryImpl) AddOrUpdateCultures(culture entity.CulturesResources) error {
+	isDefault := culture.IsDefault
+	if culture.ID > 0 {
+		stored := entity.CulturesResources{}
+		has, err := r.db.ID(culture.ID).Get(&stored)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return errors.New("culture not exists")
+		}
+		isDefault = isDefault || stored.IsDefault
+	}
+	if err := normalizeCulture(&culture, isDefault); err != nil {
+		return err
+	}
 	source := entity.CulturesResources{
 		Code: culture.Code,
 	}
@@ -265,99 +634,310 @@ func (r *CulturesRepositoryImpl) AddOrUpdateCultures(culture entity.CulturesReso
 		return errors.New("culture already exists")
 	}
 	if culture.ID > 0 {
-		_, err := r.db.ID(culture.ID).Update(&culture)
-		return err
+		// 重新启用、清空回退父语言、本地名称及复数规则时需要写入零值
+		_, err = r.db.ID(culture.ID).MustCols("parent_id", "native_name", "plural_rule", "status").Update(&culture)
 	} else {
-		_, err := r.db.Insert(&culture)
-		return err
+		_, err = r.db.Insert(&culture)
 	}
+	// 语言由所有项目共享
+	r.resetScopes(culture.ID)
+	return uniqueError(err, "culture already exists")
 }
 
 // 添加或更新资源类型
-func (r *CulturesRepositoryImpl) AddOrUpdateCulturesResourceType(data entity.CulturesResourceTypes) error {
+func (r *CulturesRepositoryImpl) AddOrUpdateCulturesResourceType(ctx context.Context, data entity.CulturesResourceTypes) error {
 	source := entity.CulturesResourceTypes{Name: data.Name}
-	has, err := r.db.Get(&source)
+	has, err := r.db.Where("project_id = ?", r.project).Get(&source)
 	if err != nil {
 		return err
 	}
 	if has && source.ID != data.ID {
 		return errors.New("culture type already exists")
 	}
-	if data.ID > 0 {
-		_, err := r.db.ID(data.ID).Update(&data)
-		return err
-	} else {
-		_, err := r.db.Insert(&data)
-		return err
-	}
+	return uniqueError(r.saveCulturesResourceType(ctx, data, data.ID == 0), "culture type already exists")
+}
+
+// saveCulturesResourceType 保存资源类型并记录历史，insert 为 true 时按传入的ID插入
+func (r *CulturesRepositoryImpl) saveCulturesResourceType(ctx context.Context, data entity.CulturesResourceTypes, insert bool) error {
+	data.ProjectID = r.project
+	_, err := r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		if insert {
+			if _, ex := s.Insert(&data); ex != nil {
+				return nil, ex
+			}
+			return nil, r.recordHistory(ctx, s, HistoryEntityType, int64(data.ID), 0, 0, nil, data)
+		}
+		old := entity.CulturesResourceTypes{}
+		has, ex := s.ID(data.ID).Where("project_id = ?", r.project).Get(&old)
+		if ex != nil {
+			return nil, ex
+		}
+		if !has {
+			return nil, fmt.Errorf("%w: %d", ErrTypeNotFound, data.ID)
+		}
+		if _, ex := s.ID(data.ID).Update(&data); ex != nil {
+			return nil, ex
+		}
+		current := entity.CulturesResourceTypes{}
+		if _, ex := s.ID(data.ID).Get(&current); ex != nil {
+			return nil, ex
+		}
+		return nil, r.recordHistory(ctx, s, HistoryEntityType, int64(data.ID), 0, 0, old, current)
+	})
+	return err
 }
 
-func (r *CulturesRepositoryImpl) DeleteCulturesResourceType(id int64) error {
-	_, err := r.db.ID(id).Delete(&entity.CulturesResourceTypes{})
+// 删除资源类型（软删除）。仍有资源键（包括回收站中的资源键）引用该类型时，reassignTo 大于 0 则将这些资源键改为该类型，否则拒绝删除
+func (r *CulturesRepositoryImpl) DeleteCulturesResourceType(ctx context.Context, id int64, reassignTo int64) error {
+	_, err := r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		old := entity.CulturesResourceTypes{}
+		has, ex := s.ID(id).Where("project_id = ?", r.project).Get(&old)
+		if ex != nil || !has {
+			return nil, ex
+		}
+		var keys []entity.CulturesResourceKeys
+		// 回收站中的资源键还原后仍引用该类型，一并检查
+		if ex := s.Unscoped().Where("type_id = ?", id).Find(&keys); ex != nil {
+			return nil, ex
+		}
+		if len(keys) > 0 {
+			if reassignTo <= 0 {
+				return nil, fmt.Errorf("%w: %d keys", ErrTypeInUse, len(keys))
+			}
+			if reassignTo == id {
+				return nil, errors.New("cannot reassign keys to the deleted culture type")
+			}
+			exists, ex := s.ID(reassignTo).Where("project_id = ?", r.project).Exist(&entity.CulturesResourceTypes{})
+			if ex != nil {
+				return nil, ex
+			}
+			if !exists {
+				return nil, errors.New("reassign culture type not exists")
+			}
+			for _, v := range keys {
+				current := v
+				current.TypeID = int32(reassignTo)
+				if _, ex := s.Unscoped().ID(v.ID).Cols("type_id").Update(&current); ex != nil {
+					return nil, ex
+				}
+				if ex := r.recordHistory(ctx, s, HistoryEntityKey, int64(v.ID), v.ID, 0, v, current); ex != nil {
+					return nil, ex
+				}
+			}
+		}
+		if _, ex := s.ID(id).Delete(&entity.CulturesResourceTypes{}); ex != nil {
+			return nil, ex
+		}
+		return nil, r.recordHistory(ctx, s, HistoryEntityType, id, 0, 0, old, nil)
+	})
 	return err
 }
 
 // 添加或更新资源键
-func (r *CulturesRepositoryImpl) AddOrUpdateCulturesResourceKey(data entity.CulturesResourceKeys) (*entity.CulturesResourceKeys, error) {
+func (r *CulturesRepositoryImpl) AddOrUpdateCulturesResourceKey(ctx context.Context, data entity.CulturesResourceKeys) (*entity.CulturesResourceKeys, error) {
 	source := entity.CulturesResourceKeys{Name: data.Name}
-	has, err := r.db.Get(&source)
+	has, err := r.db.Where("project_id = ?", r.project).Get(&source)
 	if err != nil {
 		return nil, err
 	}
 	if has && source.ID != data.ID {
 		return &source, errors.New("culture key already exists")
 	}
-	if data.ID > 0 {
-		_, err = r.db.ID(data.ID).Update(&data)
-	} else {
-		_, err = r.db.Insert(&data)
-	}
-	return &data, err
+	key, err := r.saveCulturesResourceKey(ctx, data, data.ID == 0)
+	return key, uniqueError(err, "culture key already exists")
 }
 
-// 添加或更新资源
-func (r *CulturesRepositoryImpl) AddOrUpdateCulturesResourceLang(data entity.CulturesResourceLangs) error {
-	source := entity.CulturesResourceLangs{KeyID: data.KeyID, CultureID: data.CultureID}
-	has, err := r.db.Get(&source)
-	if err != nil {
-		return err
-	}
-	if has && source.ID != data.ID {
-		return errors.New("culture lang already exists")
+// saveCulturesResourceKey 保存资源键并记录历史，insert 为 true 时按传入的ID插入。
+// 新增或修改资源类型时校验资源类型属于当前项目，未修改资源类型时不校验，回收站中的资源键可能引用已永久删除的类型
+func (r *CulturesRepositoryImpl) saveCulturesResourceKey(ctx context.Context, data entity.CulturesResourceKeys, insert bool) (*entity.CulturesResourceKeys, error) {
+	data.ProjectID = r.project
+	var oldName string
+	_, err := r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		if insert {
+			if ex := r.checkProjectType(s, data.TypeID); ex != nil {
+				return nil, ex
+			}
+			if _, ex := s.Insert(&data); ex != nil {
+				return nil, ex
+			}
+			return nil, r.recordHistory(ctx, s, HistoryEntityKey, int64(data.ID), data.ID, 0, nil, data)
+		}
+		old := entity.CulturesResourceKeys{}
+		has, ex := s.ID(data.ID).Where("project_id = ?", r.project).Get(&old)
+		if ex != nil {
+			return nil, ex
+		}
+		if !has {
+			return nil, errors.New("culture key not exists")
+		}
+		if data.TypeID != old.TypeID {
+			if ex := r.checkProjectType(s, data.TypeID); ex != nil {
+				return nil, ex
+			}
+		}
+		// 描述、上下文说明、最大字符数和占位符可以清空，零值也需要更新
+		if _, ex := s.ID(data.ID).MustCols("description", "context", "max_length", "placeholders").Update(&data); ex != nil {
+			return nil, ex
+		}
+		oldName = old.Name
+		current := entity.CulturesResourceKeys{}
+		if _, ex := s.ID(data.ID).Get(&current); ex != nil {
+			return nil, ex
+		}
+		return nil, r.recordHistory(ctx, s, HistoryEntityKey, int64(data.ID), data.ID, 0, old, current)
+	})
+	if err != nil {
+		return &data, err
 	}
-	if data.ID > 0 {
-		_, err = r.db.ID(data.ID).Update(&data)
-	} else {
-		_, err = r.db.Insert(&data)
+	r.cache.putKey(data.ID, data.Name)
+	if oldName == "" {
+		r.feed.Publish(ResourceChange{Type: ChangeAdded, KeyID: data.ID, Key: data.Name})
+	} else if data.Name != "" && oldName != data.Name {
+		// 重命名视为删除旧键并新增新键
+		r.feed.Publish(
+			ResourceChange{Type: ChangeDeleted, KeyID: data.ID, Key: oldName},
+			ResourceChange{Type: ChangeAdded, KeyID: data.ID, Key: data.Name},
+		)
 	}
-	return err
+	return &data, nil
+}
+
+// 添加或更新资源，翻译按草稿或待审核保存并清除审核人
+func (r *CulturesRepositoryImpl) AddOrUpdateCulturesResourceLang(ctx context.Context, data entity.CulturesResourceLangs) error {
+	data.Status, data.Reviewer, data.ReviewedAt = editStatus(data.Status), "", nil
+	source := entity.CulturesResourceLangs{KeyID: data.KeyID, CultureID: data.CultureID}
+	has, err := r.db.Get(&source)
+	if err != nil {
+		return err
+	}
+	if has && source.ID != data.ID {
+		return errors.New("culture lang already exists")
+	}
+	_, err = r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		if ex := r.validateLang(s, data); ex != nil {
+			return nil, ex
+		}
+		if data.ID == 0 {
+			if _, ex := s.Insert(&data); ex != nil {
+				return nil, ex
+			}
+			if ex := savePlurals(s, data); ex != nil {
+				return nil, ex
+			}
+			return nil, r.recordHistory(ctx, s, HistoryEntityLang, data.ID, data.KeyID, data.CultureID, nil, data)
+		}
+		old := entity.CulturesResourceLangs{}
+		found, ex := s.ID(data.ID).And(r.projectKeyCondition("key_id")).Get(&old)
+		if ex != nil {
+			return nil, ex
+		}
+		if old.Plurals, ex = loadPlurals(s, old.KeyID, old.CultureID); ex != nil {
+			return nil, ex
+		}
+		if !found {
+			return nil, errors.New("culture lang not exists")
+		}
+		// 修改后的翻译需要重新审核
+		if _, ex := s.ID(data.ID).MustCols("status", "reviewer", "reviewed_at").Update(&data); ex != nil {
+			return nil, ex
+		}
+		if ex := savePlurals(s, data); ex != nil {
+			return nil, ex
+		}
+		current := entity.CulturesResourceLangs{}
+		if _, ex := s.ID(data.ID).Get(&current); ex != nil {
+			return nil, ex
+		}
+		if current.Plurals, ex = loadPlurals(s, current.KeyID, current.CultureID); ex != nil {
+			return nil, ex
+		}
+		return nil, r.recordHistory(ctx, s, HistoryEntityLang, data.ID, data.KeyID, data.CultureID, old, current)
+	})
+	if err != nil {
+		return uniqueError(err, "culture lang already exists")
+	}
+	r.cache.PatchText(data.CultureID, data.KeyID, data.Text, data.Status, data.Plurals)
+	change := ResourceChange{Type: ChangeAdded, CultureID: data.CultureID, KeyID: data.KeyID, Text: data.Text}
+	if has {
+		change.Type = ChangeUpdated
+	}
+	r.feed.Publish(change)
+	return nil
 }
 
 // 添加资源
-func (r *CulturesRepositoryImpl) AddCulturesResourceLangs(key string, tid int32, cultureLang []entity.CulturesResourceLangs) error {
+func (r *CulturesRepositoryImpl) AddCulturesResourceLangs(ctx context.Context, key string, tid int32, cultureLang []entity.CulturesResourceLangs) error {
 	keyData := &entity.CulturesResourceKeys{Name: key}
-	has, ex := r.db.Get(keyData)
+	has, ex := r.db.Where("project_id = ?", r.project).Get(keyData)
 	if ex != nil {
 		return ex
 	}
+	if has {
+		for _, v := range cultureLang {
+			if err := checkTextLength(*keyData, v); err != nil {
+				return err
+			}
+		}
+	}
+	sess := r.db.NewSession()
+	defer sess.Close()
+	if err := r.checkLangsPlaceholders(sess, key, keyData.ID, cultureLang); err != nil {
+		return err
+	}
+	var inserted []entity.CulturesResourceLangs
 	// 使用 Transaction 方法执行事务
 	_, err := r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
 		if !has {
-			keyData = &entity.CulturesResourceKeys{Name: key, TypeID: tid}
-			s.Insert(keyData)
+			if ex := r.checkProjectType(s, tid); ex != nil {
+				return nil, ex
+			}
+			keyData = &entity.CulturesResourceKeys{ProjectID: r.project, Name: key, TypeID: tid}
+			if _, ex := s.Insert(keyData); ex != nil {
+				return nil, uniqueError(ex, "culture key already exists")
+			}
+			if ex := r.recordHistory(ctx, s, HistoryEntityKey, int64(keyData.ID), keyData.ID, 0, nil, *keyData); ex != nil {
+				return nil, ex
+			}
 		}
 		for _, v := range cultureLang {
 			v.KeyID = keyData.ID
-			if h, _ := s.Get(&v); h {
+			v.Status, v.Reviewer, v.ReviewedAt = editStatus(v.Status), "", nil
+			// 已有翻译的语言不覆盖，修改翻译使用 AddOrUpdateCulturesResourceLang
+			exists, ex := s.Where("key_id = ? and culture_id = ?", v.KeyID, v.CultureID).Exist(&entity.CulturesResourceLangs{})
+			if ex != nil {
+				return nil, ex
+			}
+			if exists {
 				continue
 			}
 			if _, ex := s.Insert(&v); ex != nil {
 				return nil, ex
 			}
+			if ex := savePlurals(s, v); ex != nil {
+				return nil, ex
+			}
+			if ex := r.recordHistory(ctx, s, HistoryEntityLang, v.ID, v.KeyID, v.CultureID, nil, v); ex != nil {
+				return nil, ex
+			}
+			inserted = append(inserted, v)
 		}
 		return nil, nil
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	// 事务提交后更新缓存并发布变更
+	var changes []ResourceChange
+	r.cache.putKey(keyData.ID, keyData.Name)
+	if !has {
+		changes = append(changes, ResourceChange{Type: ChangeAdded, KeyID: keyData.ID, Key: keyData.Name})
+	}
+	for _, v := range inserted {
+		r.cache.PatchText(v.CultureID, v.KeyID, v.Text, v.Status, v.Plurals)
+		changes = append(changes, ResourceChange{Type: ChangeAdded, CultureID: v.CultureID, KeyID: v.KeyID, Key: keyData.Name, Text: v.Text})
+	}
+	r.feed.Publish(changes...)
+	return nil
 }
 
 // 获取资源类型分页
@@ -365,6 +945,7 @@ func (r *CulturesRepositoryImpl) GetCulturesResourceTypePager(index, size int, t
 	var types []entity.CulturesResourceTypes
 	sess := r.db.NewSession()
 	defer sess.Close()
+	sess.Where("project_id = ?", r.project)
 	if text != "" {
 		sess.Where("name like ?", "%"+text+"%")
 	}
@@ -376,18 +957,22 @@ func (r *CulturesRepositoryImpl) GetCulturesResourceTypePager(index, size int, t
 // 根据id获取资源类型
 func (r *CulturesRepositoryImpl) GetCulturesResourceTypeByIds(ids []int32) ([]entity.CulturesResourceTypes, error) {
 	var types []entity.CulturesResourceTypes
-	err := r.db.In("id", ids).Find(&types)
+	err := r.db.In("id", ids).Where("project_id = ?", r.project).Find(&types)
 	return types, err
 }
 
 // 获取资源键分页
-func (r *CulturesRepositoryImpl) GetCulturesResourceKeyPager(index, size int, text string) ([]entity.CulturesResourceKeys, int64, error) {
+func (r *CulturesRepositoryImpl) GetCulturesResourceKeyPager(index, size int, text string, tags []string) ([]entity.CulturesResourceKeys, int64, error) {
 	var keys []entity.CulturesResourceKeys
 	sess := r.db.NewSession()
 	defer sess.Close()
+	sess.Where("project_id = ?", r.project)
 	if text != "" {
 		sess.Where("name like ?", "%"+text+"%")
 	}
+	if len(tags) > 0 {
+		sess.And(r.tagKeyCondition("id", tags))
+	}
 	offset := index*size - size
 	total, err := sess.Limit(size, offset).FindAndCount(&keys)
 	return keys, total, err
@@ -396,7 +981,7 @@ func (r *CulturesRepositoryImpl) GetCulturesResourceKeyPager(index, size int, te
 // 根据ID获取资源键
 func (r *CulturesRepositoryImpl) GetCulturesResourceKeyByIds(ids []int32) (map[int32]string, error) {
 	var types []entity.CulturesResourceKeys
-	err := r.db.In("id", ids).Find(&types)
+	err := r.db.In("id", ids).Where("project_id = ?", r.project).Find(&types)
 	if err != nil {
 		return nil, err
 	}
@@ -407,10 +992,14 @@ func (r *CulturesRepositoryImpl) GetCulturesResourceKeyByIds(ids []int32) (map[i
 	return data, nil
 }
 
-// 获取资源键列表
+// 获取资源键列表（读穿透缓存，返回值只读）
 func (r *CulturesRepositoryImpl) GetCulturesResourceKeys() (map[int32]string, error) {
+	if keys, ok := r.cache.getKeys(); ok {
+		return keys, nil
+	}
+	generation := r.cache.CurrentGeneration()
 	var types []entity.CulturesResourceKeys
-	err := r.db.Find(&types)
+	err := r.db.Where("project_id = ?", r.project).Find(&types)
 	if err != nil {
 		return nil, err
 	}
@@ -418,17 +1007,111 @@ func (r *CulturesRepositoryImpl) GetCulturesResourceKeys() (map[int32]string, er
 	for _, v := range types {
 		data[v.ID] = v.Name
 	}
+	r.cache.putKeys(generation, data)
+	return data, nil
+}
+
+// 根据过滤条件获取资源键，类型与键名条件均走索引
+func (r *CulturesRepositoryImpl) GetCulturesResourceKeysByFilter(filter ResourceKeyFilter) (map[int32]string, error) {
+	typeIds := filter.TypeIDs
+	if len(filter.TypeNames) > 0 {
+		var types []entity.CulturesResourceTypes
+		if err := r.db.In("name", filter.TypeNames).Where("project_id = ?", r.project).Find(&types); err != nil {
+			return nil, err
+		}
+		for _, v := range types {
+			typeIds = append(typeIds, v.ID)
+		}
+	}
+	data := make(map[int32]string)
+	if filter.hasTypes() && len(typeIds) == 0 {
+		// 指定的资源类型都不存在
+		return data, nil
+	}
+	sess := r.db.NewSession()
+	defer sess.Close()
+	sess.Where("project_id = ?", r.project)
+	if len(typeIds) > 0 {
+		sess.In("type_id", typeIds)
+	}
+	if where, args := filter.NameCondition(); where != "" {
+		sess.Where(where, args...)
+	}
+	if len(filter.Tags) > 0 {
+		sess.And(r.tagKeyCondition("id", filter.Tags))
+	}
+	var keys []entity.CulturesResourceKeys
+	if err := sess.Find(&keys); err != nil {
+		return nil, err
+	}
+	for _, v := range keys {
+		data[v.ID] = v.Name
+	}
+	return data, nil
+}
+
+// 根据名称获取资源键
+func (r *CulturesRepositoryImpl) GetCulturesResourceKeysByNames(names []string) (map[int32]string, error) {
+	data := make(map[int32]string)
+	if len(names) == 0 {
+		return data, nil
+	}
+	var keys []entity.CulturesResourceKeys
+	if err := r.db.In("name", names).Where("project_id = ?", r.project).Find(&keys); err != nil {
+		return nil, err
+	}
+	for _, v := range keys {
+		data[v.ID] = v.Name
+	}
 	return data, nil
 }
 
+// 获取指定语言和资源键的资源
+func (r *CulturesRepositoryImpl) GetCulturesResourceLangsByKeyIds(cultureIds []int32, keyIds []int32) ([]entity.CulturesResourceLangs, error) {
+	var langs []entity.CulturesResourceLangs
+	if len(cultureIds) == 0 || len(keyIds) == 0 {
+		return langs, nil
+	}
+	err := r.db.In("culture_id", cultureIds).In("key_id", keyIds).And(r.projectKeyCondition("key_id")).Find(&langs)
+	return langs, err
+}
+
+// 获取指定语言和资源键的复数形式
+func (r *CulturesRepositoryImpl) GetCulturesResourcePluralsByKeyIds(cultureIds []int32, keyIds []int32) ([]entity.CulturesResourcePlurals, error) {
+	var plurals []entity.CulturesResourcePlurals
+	if len(cultureIds) == 0 || len(keyIds) == 0 {
+		return plurals, nil
+	}
+	err := r.db.In("culture_id", cultureIds).In("key_id", keyIds).And(r.projectKeyCondition("key_id")).Find(&plurals)
+	return plurals, err
+}
+
+// savePlurals 保存资源的复数形式，整体替换该资源键在该语言下的所有复数形式
+func savePlurals(s *xorm.Session, data entity.CulturesResourceLangs) error {
+	if data.Plurals == nil {
+		return nil
+	}
+	if _, err := s.Where("key_id = ? and culture_id = ?", data.KeyID, data.CultureID).Delete(&entity.CulturesResourcePlurals{}); err != nil {
+		return err
+	}
+	for category, text := range data.Plurals {
+		plural := &entity.CulturesResourcePlurals{KeyID: data.KeyID, CultureID: data.CultureID, Category: category, Text: text}
+		if _, err := s.Insert(plural); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // 获取资源分页
-func (r *CulturesRepositoryImpl) GetCulturesResourceLangPager(index, size, cultureId int, text string) ([]entity.CulturesResourceLangs, int64, error) {
+func (r *CulturesRepositoryImpl) GetCulturesResourceLangPager(index, size, cultureId int, text string, statuses []int32, tags []string) ([]entity.CulturesResourceLangs, int64, error) {
 	var langs []entity.CulturesResourceLangs
 	sess := r.db.NewSession()
 	defer sess.Close()
+	sess.And(r.projectKeyCondition("key_id"))
 	if text != "" {
 		keyDatas := &[]entity.CulturesResourceKeys{}
-		ex := r.db.Where("name like ?", "%"+text+"%").Find(keyDatas)
+		ex := r.db.Where("project_id = ? and name like ?", r.project, "%"+text+"%").Find(keyDatas)
 		if ex == nil {
 			var ids []int32
 			for _, v := range *keyDatas {
@@ -443,6 +1126,12 @@ func (r *CulturesRepositoryImpl) GetCulturesResourceLangPager(index, size, cultu
 	if cultureId > 0 {
 		sess.Where("culture_id = ?", cultureId)
 	}
+	if len(statuses) > 0 {
+		sess.In("status", statuses)
+	}
+	if len(tags) > 0 {
+		sess.And(r.tagKeyCondition("key_id", tags))
+	}
 	offset := index*size - size
 	total, err := sess.Limit(size, offset).FindAndCount(&langs)
 	return langs, total, err
@@ -451,21 +1140,495 @@ func (r *CulturesRepositoryImpl) GetCulturesResourceLangPager(index, size, cultu
 // 根据keyId获取资源
 func (r *CulturesRepositoryImpl) GetCulturesResourceLangByKeyId(keyId int) ([]entity.CulturesResourceLangs, error) {
 	var langs []entity.CulturesResourceLangs
-	err := r.db.Where("key_id = ?", keyId).Find(&langs)
+	err := r.db.Where("key_id = ?", keyId).And(r.projectKeyCondition("key_id")).Find(&langs)
 	return langs, err
 }
 
-// 删除资源键
-func (r *CulturesRepositoryImpl) DeleteCulturesResourceKey(id int32) error {
+// 流转资源审核状态，所有资源在同一事务中流转，任一资源状态不允许流转或已被并发修改时整体失败
+func (r *CulturesRepositoryImpl) TransitionCulturesResourceLangs(ctx context.Context, ids []int64, status int32, reviewer string) error {
+	if !IsValidStatus(status) {
+		return fmt.Errorf("%w to %d", ErrInvalidTransition, status)
+	}
+	var langs []entity.CulturesResourceLangs
+	if err := r.db.In("id", ids).And(r.projectKeyCondition("key_id")).Find(&langs); err != nil {
+		return err
+	}
+	found := make(map[int64]bool, len(langs))
+	for _, v := range langs {
+		found[v.ID] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return fmt.Errorf("culture lang %d not exists", id)
+		}
+	}
+	for _, v := range langs {
+		if err := CheckTransition(v.Status, status); err != nil {
+			return fmt.Errorf("culture lang %d: %w", v.ID, err)
+		}
+	}
+	update := entity.CulturesResourceLangs{Status: status}
+	if isReviewed(status) {
+		now := time.Now()
+		update.Reviewer = reviewer
+		update.ReviewedAt = &now
+	}
 	_, err := r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
-		_, err := s.ID(id).Delete(&entity.CulturesResourceKeys{
-			ID: id,
-		})
+		for _, v := range langs {
+			affected, ex := s.ID(v.ID).Where("status = ?", v.Status).Cols("status", "reviewer", "reviewed_at").Update(&update)
+			if ex != nil {
+				return nil, ex
+			}
+			if affected == 0 {
+				return nil, fmt.Errorf("culture lang %d was modified concurrently", v.ID)
+			}
+			current := v
+			current.Status, current.Reviewer, current.ReviewedAt = update.Status, update.Reviewer, update.ReviewedAt
+			if ex := r.recordHistory(ctx, s, HistoryEntityLang, v.ID, v.KeyID, v.CultureID, v, current); ex != nil {
+				return nil, ex
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	// 事务提交后更新缓存并发布变更，订阅方根据审核状态重新计算可见的翻译
+	changes := make([]ResourceChange, 0, len(langs))
+	for _, v := range langs {
+		r.cache.patchStatus(v.CultureID, v.KeyID, status)
+		changes = append(changes, ResourceChange{Type: ChangeUpdated, CultureID: v.CultureID, KeyID: v.KeyID, Text: v.Text})
+	}
+	r.feed.Publish(changes...)
+	return nil
+}
+
+// 删除资源键（软删除），其语言一并移入回收站，超过保留期后永久删除
+func (r *CulturesRepositoryImpl) DeleteCulturesResourceKey(ctx context.Context, id int32) error {
+	keyData := entity.CulturesResourceKeys{}
+	_, err := r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		has, err := s.ID(id).Where("project_id = ?", r.project).Get(&keyData)
+		if err != nil || !has {
+			return nil, err
+		}
+		deleted := time.Now()
+		if _, err := s.Unscoped().ID(id).Cols("deleted_at").Update(&entity.CulturesResourceKeys{DeletedAt: deleted}); err != nil {
+			return nil, err
+		}
+		_, err = s.Unscoped().Where("key_id = ? and (deleted_at is null or deleted_at < ?)", id, deletedEpoch).
+			Cols("deleted_at").Update(&entity.CulturesResourceLangs{DeletedAt: deleted})
 		if err != nil {
 			return nil, err
 		}
-		_, err = s.Where("key_id = ?", id).Delete(&entity.CulturesResourceLangs{})
+		return nil, r.recordHistory(ctx, s, HistoryEntityKey, int64(id), id, 0, keyData, nil)
+	})
+	if err == nil {
+		r.cache.removeKey(id)
+		r.feed.Publish(ResourceChange{Type: ChangeDeleted, KeyID: id, Key: keyData.Name})
+	}
+	return err
+}
+
+// 获取回收站中的资源键分页
+func (r *CulturesRepositoryImpl) GetDeletedCulturesResourceKeyPager(index, size int) ([]entity.CulturesResourceKeys, int64, error) {
+	var keys []entity.CulturesResourceKeys
+	sess := r.db.NewSession()
+	defer sess.Close()
+	offset := index*size - size
+	total, err := sess.Unscoped().Where("project_id = ? and deleted_at > ?", r.project, deletedEpoch).Desc("deleted_at").Limit(size, offset).FindAndCount(&keys)
+	return keys, total, err
+}
+
+// 获取回收站中的资源类型分页
+func (r *CulturesRepositoryImpl) GetDeletedCulturesResourceTypePager(index, size int) ([]entity.CulturesResourceTypes, int64, error) {
+	var types []entity.CulturesResourceTypes
+	sess := r.db.NewSession()
+	defer sess.Close()
+	offset := index*size - size
+	total, err := sess.Unscoped().Where("project_id = ? and deleted_at > ?", r.project, deletedEpoch).Desc("deleted_at").Limit(size, offset).FindAndCount(&types)
+	return types, total, err
+}
+
+// 从回收站还原资源键及其语言，已有同名资源键时拒绝还原
+func (r *CulturesRepositoryImpl) RestoreCulturesResourceKey(ctx context.Context, id int32) error {
+	keyData := entity.CulturesResourceKeys{}
+	has, err := r.db.Unscoped().ID(id).Where("project_id = ?", r.project).Get(&keyData)
+	if err != nil {
+		return err
+	}
+	if !has || !keyData.DeletedAt.After(deletedEpoch) {
+		return errors.New("culture key not in trash")
+	}
+	source := entity.CulturesResourceKeys{Name: keyData.Name}
+	if has, err := r.db.Where("project_id = ?", r.project).Get(&source); err != nil || has {
+		if err == nil {
+			err = errors.New("culture key already exists")
+		}
+		return err
+	}
+	_, err = r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		if _, err := s.Unscoped().ID(id).Cols("deleted_at").Update(&entity.CulturesResourceKeys{}); err != nil {
+			return nil, err
+		}
+		_, err := s.Unscoped().Where("key_id = ? and deleted_at > ?", id, deletedEpoch).Cols("deleted_at").Update(&entity.CulturesResourceLangs{})
+		if err != nil {
+			return nil, err
+		}
+		keyData.DeletedAt = time.Time{}
+		return nil, r.recordHistory(ctx, s, HistoryEntityKey, int64(id), id, 0, nil, keyData)
+	})
+	if err != nil {
+		return uniqueError(err, "culture key already exists")
+	}
+	// 还原的语言需要重新加载到快照中
+	r.cache.Invalidate()
+	r.feed.Publish(ResourceChange{Type: ChangeAdded, KeyID: id, Key: keyData.Name})
+	return nil
+}
+
+// 从回收站还原资源类型，已有同名资源类型时拒绝还原
+func (r *CulturesRepositoryImpl) RestoreCulturesResourceType(ctx context.Context, id int64) error {
+	typeData := entity.CulturesResourceTypes{}
+	has, err := r.db.Unscoped().ID(id).Where("project_id = ?", r.project).Get(&typeData)
+	if err != nil {
+		return err
+	}
+	if !has || !typeData.DeletedAt.After(deletedEpoch) {
+		return errors.New("culture type not in trash")
+	}
+	source := entity.CulturesResourceTypes{Name: typeData.Name}
+	if has, err := r.db.Where("project_id = ?", r.project).Get(&source); err != nil || has {
+		if err == nil {
+			err = errors.New("culture type already exists")
+		}
+		return err
+	}
+	_, err = r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		if _, err := s.Unscoped().ID(id).Cols("deleted_at").Update(&entity.CulturesResourceTypes{}); err != nil {
+			return nil, err
+		}
+		typeData.DeletedAt = time.Time{}
+		return nil, r.recordHistory(ctx, s, HistoryEntityType, id, 0, 0, nil, typeData)
+	})
+	return uniqueError(err, "culture type already exists")
+}
+
+// 获取资源历史分页，按时间倒序
+func (r *CulturesRepositoryImpl) GetCulturesResourceHistoryPager(index, size int, filter HistoryFilter) ([]entity.CulturesResourceHistories, int64, error) {
+	var histories []entity.CulturesResourceHistories
+	sess := r.db.NewSession()
+	defer sess.Close()
+	sess.Where("project_id = ?", r.project)
+	if filter.KeyID > 0 {
+		sess.Where("key_id = ?", filter.KeyID)
+		if len(filter.CultureIDs) > 0 {
+			sess.And(builder.Or(builder.In("culture_id", filter.CultureIDs), builder.Eq{"entity": HistoryEntityKey}))
+		}
+	} else {
+		sess.Where("entity = ? and entity_id = ?", HistoryEntityType, filter.TypeID)
+	}
+	offset := index*size - size
+	total, err := sess.Desc("id").Limit(size, offset).FindAndCount(&histories)
+	return histories, total, err
+}
+
+// 还原资源历史版本，还原为该版本修改后的值，删除记录还原为删除前的值，还原操作本身也记录历史
+func (r *CulturesRepositoryImpl) RestoreCulturesResourceRevision(ctx context.Context, id int64) error {
+	h := entity.CulturesResourceHistories{}
+	has, err := r.db.ID(id).Where("project_id = ?", r.project).Get(&h)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errors.New("revision not exists")
+	}
+	value := []byte(revisionValue(h))
+	switch h.Entity {
+	case HistoryEntityType:
+		var data entity.CulturesResourceTypes
+		if err := json.Unmarshal(value, &data); err != nil {
+			return err
+		}
+		current := entity.CulturesResourceTypes{}
+		exists, err := r.db.Unscoped().ID(data.ID).Where("project_id = ?", r.project).Get(&current)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return r.saveCulturesResourceType(ctx, data, true)
+		}
+		if current.DeletedAt.After(deletedEpoch) {
+			if err := r.RestoreCulturesResourceType(ctx, int64(data.ID)); err != nil {
+				return err
+			}
+		}
+		return r.AddOrUpdateCulturesResourceType(ctx, data)
+	case HistoryEntityKey:
+		var data entity.CulturesResourceKeys
+		if err := json.Unmarshal(value, &data); err != nil {
+			return err
+		}
+		current := entity.CulturesResourceKeys{}
+		exists, err := r.db.Unscoped().ID(data.ID).Where("project_id = ?", r.project).Get(&current)
+		if err != nil {
+			return err
+		}
+		if exists && current.DeletedAt.After(deletedEpoch) {
+			// 资源键在回收站中时先还原
+			if err := r.RestoreCulturesResourceKey(ctx, data.ID); err != nil {
+				return err
+			}
+		}
+		if !exists {
+			// 资源键已永久删除时按原ID重建，以便继续还原其语言的历史
+			source := entity.CulturesResourceKeys{Name: data.Name}
+			if has, err := r.db.Where("project_id = ?", r.project).Get(&source); err != nil || has {
+				if err == nil {
+					err = errors.New("culture key already exists")
+				}
+				return err
+			}
+			_, err = r.saveCulturesResourceKey(ctx, data, true)
+			return uniqueError(err, "culture key already exists")
+		}
+		_, err = r.AddOrUpdateCulturesResourceKey(ctx, data)
+		return err
+	case HistoryEntityLang:
+		var data entity.CulturesResourceLangs
+		if err := json.Unmarshal(value, &data); err != nil {
+			return err
+		}
+		exists, err := r.db.ID(data.KeyID).Where("project_id = ?", r.project).Exist(&entity.CulturesResourceKeys{})
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.New("culture key not exists, restore the key first")
+		}
+		// 当前的资源语言可能已被删除后重新添加，按资源键和语言定位
+		source := entity.CulturesResourceLangs{KeyID: data.KeyID, CultureID: data.CultureID}
+		if _, err := r.db.Get(&source); err != nil {
+			return err
+		}
+		data.ID = source.ID
+		// 还原的翻译需要重新审核，不沿用历史版本的审核结果
+		data.Status, data.Reviewer, data.ReviewedAt = StatusNeedsReview, "", nil
+		return r.AddOrUpdateCulturesResourceLang(ctx, data)
+	}
+	return fmt.Errorf("unsupported revision entity %q", h.Entity)
+}
+
+// 根据 Code 获取语言资源快照
+func (r *CulturesRepositoryImpl) GetCultureSnapshot(code string) (*CultureSnapshot, error) {
+	if s, ok := r.cache.GetCulture(code); ok {
+		return s, nil
+	}
+	generation := r.cache.CurrentGeneration()
+	culture := &entity.CulturesResources{Code: code}
+	has, err := r.db.Get(culture)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("culture not exists")
+	}
+	var langs []entity.CulturesResourceLangs
+	if err := r.db.Where("culture_id = ?", culture.ID).And(r.projectKeyCondition("key_id")).Find(&langs); err != nil {
+		return nil, err
+	}
+	var plurals []entity.CulturesResourcePlurals
+	if err := r.db.Where("culture_id = ?", culture.ID).And(r.projectKeyCondition("key_id")).Find(&plurals); err != nil {
 		return nil, err
+	}
+	s := NewCultureSnapshot(*culture, langs, plurals)
+	r.cache.PutCulture(generation, s)
+	return s, nil
+}
+
+// 获取缓存统计信息
+func (r *CulturesRepositoryImpl) GetCacheStats() CacheStats {
+	return r.cache.Stats()
+}
+
+// 获取语言的回退链
+func (r *CulturesRepositoryImpl) GetCultureFallbackChain(code string) ([]entity.CulturesResources, error) {
+	cultures, err := r.GetCultures()
+	if err != nil {
+		return nil, err
+	}
+	return BuildFallbackChain(cultures, code)
+}
+
+// 订阅资源变更
+func (r *CulturesRepositoryImpl) SubscribeResourceChanges() *ChangeSubscription {
+	return r.feed.Subscribe()
+}
+
+// 获取指定序号之后的资源变更
+func (r *CulturesRepositoryImpl) GetResourceChangesSince(seq uint64) ([]ResourceChange, bool) {
+	return r.feed.Since(seq)
+}
+
+// 获取标签分页
+func (r *CulturesRepositoryImpl) GetCulturesResourceTagPager(index, size int, text string) ([]entity.CulturesResourceTags, int64, error) {
+	var tags []entity.CulturesResourceTags
+	sess := r.db.NewSession()
+	defer sess.Close()
+	sess.Where("project_id = ?", r.project)
+	if text != "" {
+		sess.Where("name like ?", "%"+text+"%")
+	}
+	offset := index*size - size
+	total, err := sess.Limit(size, offset).FindAndCount(&tags)
+	return tags, total, err
+}
+
+// 添加或更新标签
+func (r *CulturesRepositoryImpl) AddOrUpdateCulturesResourceTag(data entity.CulturesResourceTags) error {
+	names, err := normalizeTags([]string{data.Name})
+	if err != nil {
+		return err
+	}
+	data.Name, data.ProjectID = names[0], r.project
+	source := entity.CulturesResourceTags{Name: data.Name}
+	has, err := r.db.Where("project_id = ?", r.project).Get(&source)
+	if err != nil {
+		return err
+	}
+	if has && source.ID != data.ID {
+		return errors.New("culture tag already exists")
+	}
+	if data.ID > 0 {
+		exists, ex := r.db.ID(data.ID).Where("project_id = ?", r.project).Exist(&entity.CulturesResourceTags{})
+		if ex != nil {
+			return ex
+		}
+		if !exists {
+			return errors.New("culture tag not exists")
+		}
+		_, err = r.db.ID(data.ID).Update(&data)
+	} else {
+		_, err = r.db.Insert(&data)
+	}
+	return uniqueError(err, "culture tag already exists")
+}
+
+// 删除标签及其与资源键的关联，只能删除当前项目的标签
+func (r *CulturesRepositoryImpl) DeleteCulturesResourceTag(id int32) error {
+	_, err := r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		has, ex := s.ID(id).Where("project_id = ?", r.project).Exist(&entity.CulturesResourceTags{})
+		if ex != nil || !has {
+			return nil, ex
+		}
+		if _, err := s.Where("tag_id = ?", id).Delete(&entity.CulturesResourceKeyTags{}); err != nil {
+			return nil, err
+		}
+		_, err := s.ID(id).Delete(&entity.CulturesResourceTags{})
+		return nil, err
+	})
+	return err
+}
+
+// 为资源键添加或移除标签
+func (r *CulturesRepositoryImpl) TagCulturesResourceKeys(keyIds []int32, add []string, remove []string) error {
+	add, err := normalizeTags(add)
+	if err != nil {
+		return err
+	}
+	remove, err = normalizeTags(remove)
+	if err != nil {
+		return err
+	}
+	// 只能为当前项目的资源键设置标签
+	unique := make(map[int32]bool, len(keyIds))
+	for _, id := range keyIds {
+		unique[id] = true
+	}
+	if len(unique) > 0 {
+		count, err := r.db.In("id", keyIds).Where("project_id = ?", r.project).Count(&entity.CulturesResourceKeys{})
+		if err != nil {
+			return err
+		}
+		if int(count) != len(unique) {
+			return errors.New("culture key not exists")
+		}
+	}
+	_, err = r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		if len(remove) > 0 {
+			var tags []entity.CulturesResourceTags
+			if err := s.Where("project_id = ?", r.project).In("name", remove).Find(&tags); err != nil {
+				return nil, err
+			}
+			tagIds := make([]int32, 0, len(tags))
+			for _, v := range tags {
+				tagIds = append(tagIds, v.ID)
+			}
+			if len(tagIds) > 0 {
+				if _, err := s.In("key_id", keyIds).And(r.projectKeyCondition("key_id")).In("tag_id", tagIds).Delete(&entity.CulturesResourceKeyTags{}); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if len(add) == 0 {
+			return nil, nil
+		}
+		tags, err := r.ensureTags(s, add)
+		if err != nil {
+			return nil, err
+		}
+		var links []entity.CulturesResourceKeyTags
+		if err := s.In("key_id", keyIds).And(r.projectKeyCondition("key_id")).Find(&links); err != nil {
+			return nil, err
+		}
+		linked := make(map[[2]int32]bool, len(links))
+		for _, v := range links {
+			linked[[2]int32{v.KeyID, v.TagID}] = true
+		}
+		for _, keyID := range keyIds {
+			for _, tag := range tags {
+				if linked[[2]int32{keyID, tag.ID}] {
+					continue
+				}
+				if _, err := s.Insert(&entity.CulturesResourceKeyTags{KeyID: keyID, TagID: tag.ID}); err != nil {
+					return nil, err
+				}
+				linked[[2]int32{keyID, tag.ID}] = true
+			}
+		}
+		return nil, nil
 	})
 	return err
 }
+
+// 获取资源键的标签，其他项目的资源键忽略
+func (r *CulturesRepositoryImpl) GetCulturesResourceKeyTags(keyIds []int32) (map[int32][]string, error) {
+	data := make(map[int32][]string)
+	if len(keyIds) == 0 {
+		return data, nil
+	}
+	var links []entity.CulturesResourceKeyTags
+	if err := r.db.In("key_id", keyIds).And(r.projectKeyCondition("key_id")).Find(&links); err != nil {
+		return nil, err
+	}
+	if len(links) == 0 {
+		return data, nil
+	}
+	tagIds := make([]int32, 0, len(links))
+	for _, v := range links {
+		tagIds = append(tagIds, v.TagID)
+	}
+	var tags []entity.CulturesResourceTags
+	if err := r.db.In("id", tagIds).Where("project_id = ?", r.project).Find(&tags); err != nil {
+		return nil, err
+	}
+	names := make(map[int32]string, len(tags))
+	for _, v := range tags {
+		names[v.ID] = v.Name
+	}
+	for _, v := range links {
+		if name, ok := names[v.TagID]; ok {
+			data[v.KeyID] = append(data[v.KeyID], name)
+		}
+	}
+	return data, nil
+}
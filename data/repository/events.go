@@ -0,0 +1,126 @@
+// repository/events.go
+package repository
+
+import (
+	"sync"
+	"time"
+)
+
+// ChangeType 资源变更类型
+type ChangeType int
+
+const (
+	ChangeAdded   ChangeType = iota + 1 // 新增
+	ChangeUpdated                       // 更新
+	ChangeDeleted                       // 删除
+	ChangeReset                         // 语言本身变更，需要重新获取全量快照
+)
+
+// 变更缓冲区保留的最近变更数，断线重连的客户端只能从缓冲区内续传
+const changeBufferSize = 4096
+
+// 订阅通道的缓冲大小，消费过慢的订阅会被关闭
+const subscriberBufferSize = 256
+
+// ResourceChange 资源变更事件
+type ResourceChange struct {
+	Seq       uint64     // 变更序号，单调递增
+	Type      ChangeType // 变更类型
+	CultureID int32      // 语言ID，0 表示资源键变更，影响所有语言
+	KeyID     int32      // 资源键ID
+	Key       string     // 资源键名称
+	Text      string     // 文本
+}
+
+// ChangeSubscription 资源变更订阅
+type ChangeSubscription struct {
+	C     <-chan ResourceChange // 变更通道，关闭表示订阅因消费过慢被丢弃
+	Epoch int64                 // 变更流纪元，服务重启后变化
+	Seq   uint64                // 订阅时的最新变更序号
+	feed  *ChangeFeed
+	ch    chan ResourceChange
+}
+
+// Close 取消订阅
+func (s *ChangeSubscription) Close() {
+	s.feed.unsubscribe(s.ch)
+}
+
+// ChangeFeed 资源变更广播，保留最近的变更用于断线续传。
+// 变更只在本进程内广播且不持久化：多副本部署时订阅方收不到其他副本写入的变更，服务重启后纪元变化，之前的序号全部失效
+type ChangeFeed struct {
+	sync.Mutex
+	epoch  int64
+	seq    uint64
+	buffer []ResourceChange // 环形缓冲区
+	subs   map[chan ResourceChange]struct{}
+}
+
+// NewChangeFeed 创建资源变更流，纪元为创建时间
+func NewChangeFeed() *ChangeFeed {
+	return &ChangeFeed{
+		epoch:  time.Now().UnixNano(),
+		buffer: make([]ResourceChange, 0, changeBufferSize),
+		subs:   make(map[chan ResourceChange]struct{}),
+	}
+}
+
+// Publish 发布变更，为每个变更分配序号
+func (f *ChangeFeed) Publish(changes ...ResourceChange) {
+	f.Lock()
+	defer f.Unlock()
+	for _, change := range changes {
+		f.seq++
+		change.Seq = f.seq
+		if len(f.buffer) < changeBufferSize {
+			f.buffer = append(f.buffer, change)
+		} else {
+			f.buffer[(f.seq-1)%changeBufferSize] = change
+		}
+		for ch := range f.subs {
+			select {
+			case ch <- change:
+			default:
+				// 消费过慢，关闭订阅，由订阅方从缓冲区续传
+				delete(f.subs, ch)
+				close(ch)
+			}
+		}
+	}
+}
+
+// Subscribe 订阅变更
+func (f *ChangeFeed) Subscribe() *ChangeSubscription {
+	f.Lock()
+	defer f.Unlock()
+	ch := make(chan ResourceChange, subscriberBufferSize)
+	f.subs[ch] = struct{}{}
+	return &ChangeSubscription{C: ch, Epoch: f.epoch, Seq: f.seq, feed: f, ch: ch}
+}
+
+func (f *ChangeFeed) unsubscribe(ch chan ResourceChange) {
+	f.Lock()
+	defer f.Unlock()
+	if _, ok := f.subs[ch]; ok {
+		delete(f.subs, ch)
+		close(ch)
+	}
+}
+
+// Since 获取序号 seq 之后的变更，缓冲区已不包含所需变更时返回 false
+func (f *ChangeFeed) Since(seq uint64) ([]ResourceChange, bool) {
+	f.Lock()
+	defer f.Unlock()
+	if seq > f.seq {
+		return nil, false
+	}
+	oldest := f.seq - uint64(len(f.buffer)) + 1
+	if seq+1 < oldest {
+		return nil, false
+	}
+	changes := make([]ResourceChange, 0, f.seq-seq)
+	for s := seq + 1; s <= f.seq; s++ {
+		changes = append(changes, f.buffer[(s-1)%changeBufferSize])
+	}
+	return changes, true
+}
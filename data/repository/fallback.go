@@ -0,0 +1,74 @@
+// repository/fallback.go
+package repository
+
+import (
+	"errors"
+	"i18n-service/data/entity"
+	"strings"
+)
+
+// BuildFallbackChain 构建语言回退链。
+// 优先使用语言显式配置的父语言，未配置时按 BCP 47 子标签逐级截断查找，
+// 例如 zh-Hant-TW → zh-Hant → zh，最后回退到默认语言。
+// 请求的语言代码不存在时同样逐级截断，回退链从最接近的已有语言开始。
+func BuildFallbackChain(cultures []entity.CulturesResources, code string) ([]entity.CulturesResources, error) {
+	byCode := make(map[string]entity.CulturesResources, len(cultures))
+	byID := make(map[int32]entity.CulturesResources, len(cultures))
+	for _, v := range cultures {
+		byCode[normalizeCode(v.Code)] = v
+		byID[v.ID] = v
+	}
+	var current entity.CulturesResources
+	found := false
+	for c := normalizeCode(code); c != "" && !found; c = truncateCode(c) {
+		current, found = byCode[c]
+	}
+	if !found {
+		return nil, errors.New("culture not exists")
+	}
+	visited := map[int32]bool{current.ID: true}
+	chain := []entity.CulturesResources{current}
+	for {
+		next, ok := parentCulture(current, byCode, byID)
+		if !ok || visited[next.ID] {
+			break
+		}
+		visited[next.ID] = true
+		chain = append(chain, next)
+		current = next
+	}
+	for _, v := range cultures {
+		if v.IsDefault && !visited[v.ID] {
+			chain = append(chain, v)
+			break
+		}
+	}
+	return chain, nil
+}
+
+// parentCulture 查找语言的父语言
+func parentCulture(culture entity.CulturesResources, byCode map[string]entity.CulturesResources, byID map[int32]entity.CulturesResources) (entity.CulturesResources, bool) {
+	if culture.ParentID > 0 {
+		parent, ok := byID[culture.ParentID]
+		return parent, ok
+	}
+	for code := truncateCode(normalizeCode(culture.Code)); code != ""; code = truncateCode(code) {
+		if parent, ok := byCode[code]; ok {
+			return parent, true
+		}
+	}
+	return entity.CulturesResources{}, false
+}
+
+// normalizeCode 统一语言代码格式，zh_Hant_TW 与 zh-hant-tw 视为相同
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "_", "-"))
+}
+
+// truncateCode 去掉语言代码的最后一个子标签
+func truncateCode(code string) string {
+	if i := strings.LastIndex(code, "-"); i > 0 {
+		return code[:i]
+	}
+	return ""
+}
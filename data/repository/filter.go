@@ -0,0 +1,64 @@
+// repository/filter.go
+package repository
+
+import "strings"
+
+// ResourceKeyFilter 资源键过滤条件，不同条件之间为“且”，同一条件的多个值之间为“或”
+type ResourceKeyFilter struct {
+	TypeIDs   []int32  // 资源类型ID
+	TypeNames []string // 资源类型名称
+	Prefixes  []string // 键名前缀，例如 mobile.
+	Patterns  []string // 键名通配符，* 匹配任意字符，? 匹配单个字符
+	Tags      []string // 标签名称
+}
+
+// IsEmpty 是否没有任何过滤条件
+func (f ResourceKeyFilter) IsEmpty() bool {
+	return !f.hasTypes() && len(f.Prefixes) == 0 && len(f.Patterns) == 0 && len(f.Tags) == 0
+}
+
+func (f ResourceKeyFilter) hasTypes() bool {
+	return len(f.TypeIDs) > 0 || len(f.TypeNames) > 0
+}
+
+// NameCondition 将前缀和通配符转换为 LIKE 条件，前缀匹配可以使用 name 索引
+func (f ResourceKeyFilter) NameCondition() (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	for _, v := range f.Prefixes {
+		conds = append(conds, "name LIKE ?")
+		args = append(args, escapeLike(v)+"%")
+	}
+	for _, v := range f.Patterns {
+		conds = append(conds, "name LIKE ?")
+		args = append(args, globToLike(v))
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return "(" + strings.Join(conds, " OR ") + ")", args
+}
+
+// escapeLike 转义 LIKE 中的特殊字符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// globToLike 将通配符转换为 LIKE 模式
+func globToLike(pattern string) string {
+	var b strings.Builder
+	for _, ch := range pattern {
+		switch ch {
+		case '*':
+			b.WriteByte('%')
+		case '?':
+			b.WriteByte('_')
+		case '\\', '%', '_':
+			b.WriteByte('\\')
+			b.WriteRune(ch)
+		default:
+			b.WriteRune(ch)
+		}
+	}
+	return b.String()
+}
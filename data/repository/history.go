@@ -0,0 +1,101 @@
+// repository/history.go
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"i18n-service/data/entity"
+
+	"xorm.io/xorm"
+)
+
+// 历史记录的实体类型
+const (
+	HistoryEntityLang = "lang" // 资源语言
+	HistoryEntityKey  = "key"  // 资源键
+	HistoryEntityType = "type" // 资源类型
+)
+
+// 历史记录的操作类型
+const (
+	HistoryActionCreate = "create"
+	HistoryActionUpdate = "update"
+	HistoryActionDelete = "delete"
+)
+
+type actorContextKey struct{}
+
+// WithActor 返回携带操作人的上下文，变更写入历史记录时使用
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// actorFromContext 获取上下文中的操作人
+func actorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorContextKey{}).(string)
+	return actor
+}
+
+// HistoryFilter 历史记录查询条件
+type HistoryFilter struct {
+	KeyID      int32   // 资源键ID，查询该资源键及其所有语言的历史
+	TypeID     int32   // 资源类型ID，KeyID 为 0 时查询该资源类型的历史
+	CultureIDs []int32 // 只查询这些语言的资源语言历史，资源键本身的历史始终包含
+}
+
+// recordHistory 在事务中写入一条当前项目的历史记录，oldValue 为 nil 表示新增，newValue 为 nil 表示删除
+func (r *CulturesRepositoryImpl) recordHistory(ctx context.Context, s *xorm.Session, entityType string, entityID int64, keyID, cultureID int32, oldValue, newValue interface{}) error {
+	h := &entity.CulturesResourceHistories{
+		ProjectID: r.project,
+		Entity:    entityType,
+		EntityID:  entityID,
+		KeyID:     keyID,
+		CultureID: cultureID,
+		Action:    HistoryActionUpdate,
+		Actor:     actorFromContext(ctx),
+	}
+	if oldValue == nil {
+		h.Action = HistoryActionCreate
+	} else {
+		b, err := json.Marshal(oldValue)
+		if err != nil {
+			return err
+		}
+		h.OldValue = string(b)
+	}
+	if newValue == nil {
+		h.Action = HistoryActionDelete
+	} else {
+		b, err := json.Marshal(newValue)
+		if err != nil {
+			return err
+		}
+		h.NewValue = string(b)
+	}
+	_, err := s.Insert(h)
+	return err
+}
+
+// revisionValue 获取历史记录还原后的值，删除记录还原为删除前的值
+func revisionValue(h entity.CulturesResourceHistories) string {
+	if h.NewValue != "" {
+		return h.NewValue
+	}
+	return h.OldValue
+}
+
+// loadPlurals 读取资源语言的复数形式
+func loadPlurals(s *xorm.Session, keyID, cultureID int32) (map[string]string, error) {
+	var plurals []entity.CulturesResourcePlurals
+	if err := s.Where("key_id = ? and culture_id = ?", keyID, cultureID).Find(&plurals); err != nil {
+		return nil, err
+	}
+	if len(plurals) == 0 {
+		return nil, nil
+	}
+	forms := make(map[string]string, len(plurals))
+	for _, v := range plurals {
+		forms[v.Category] = v.Text
+	}
+	return forms, nil
+}
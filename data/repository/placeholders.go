@@ -0,0 +1,139 @@
+// repository/placeholders.go
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"i18n-service/data/entity"
+	"i18n-service/msgformat"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ErrPlaceholderMismatch 翻译的占位符与默认语言的翻译不一致
+var ErrPlaceholderMismatch = errors.New("placeholder mismatch")
+
+var (
+	// mustachePattern {{name}} 形式的占位符
+	mustachePattern = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+	// printfPattern printf 形式的占位符，%% 为转义的百分号
+	printfPattern = regexp.MustCompile(`%%|%(?:\d+\$)?[-+#0]*(?:\d+|\*)?(?:\.(?:\d+|\*))?(?:hh|h|ll|l)?[@dDiuUxXoOfFeEgGcCsSpaAqvtTb]`)
+	// simplePattern ICU 模式解析失败时按 {name} 提取占位符
+	simplePattern = regexp.MustCompile(`\{\s*([\p{L}\p{N}_.-]+)\s*[,}]`)
+)
+
+// Placeholders 提取文本中的占位符，支持 ICU 参数 {name}、{{name}} 和 printf 形式的 %s、%1$d、%@。
+// 命名占位符去重，printf 占位符按出现次数计数。
+func Placeholders(text string) map[string]int {
+	counts := make(map[string]int)
+	for _, m := range mustachePattern.FindAllStringSubmatch(text, -1) {
+		counts["{{"+m[1]+"}}"] = 1
+	}
+	rest := mustachePattern.ReplaceAllString(text, "")
+	for _, m := range printfPattern.FindAllString(rest, -1) {
+		if m != "%%" {
+			counts[m]++
+		}
+	}
+	if msg, err := msgformat.Parse(rest); err == nil {
+		for _, name := range msg.Arguments() {
+			counts["{"+name+"}"] = 1
+		}
+	} else {
+		for _, m := range simplePattern.FindAllStringSubmatch(rest, -1) {
+			counts["{"+m[1]+"}"] = 1
+		}
+	}
+	return counts
+}
+
+// PlaceholderMismatch 翻译与参考文本的占位符差异
+type PlaceholderMismatch struct {
+	Missing    []string // 参考文本中有而翻译中缺少的占位符
+	Unexpected []string // 翻译中多出的占位符
+}
+
+// IsEmpty 占位符是否一致
+func (m PlaceholderMismatch) IsEmpty() bool {
+	return len(m.Missing) == 0 && len(m.Unexpected) == 0
+}
+
+func (m PlaceholderMismatch) String() string {
+	var parts []string
+	if len(m.Missing) > 0 {
+		parts = append(parts, "missing "+strings.Join(m.Missing, ", "))
+	}
+	if len(m.Unexpected) > 0 {
+		parts = append(parts, "unexpected "+strings.Join(m.Unexpected, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// ComparePlaceholders 比较翻译与参考文本的占位符，printf 占位符的个数也需要一致
+func ComparePlaceholders(reference, text string) PlaceholderMismatch {
+	want, got := Placeholders(reference), Placeholders(text)
+	var m PlaceholderMismatch
+	for name, n := range want {
+		if got[name] < n {
+			m.Missing = append(m.Missing, name)
+		}
+	}
+	for name, n := range got {
+		if want[name] < n {
+			m.Unexpected = append(m.Unexpected, name)
+		}
+	}
+	sort.Strings(m.Missing)
+	sort.Strings(m.Unexpected)
+	return m
+}
+
+// pluralCategoryOrder CLDR 复数类别的顺序
+var pluralCategoryOrder = []string{"zero", "one", "two", "few", "many", "other"}
+
+// FormMismatch 翻译的一个形式与参考翻译对应形式的占位符差异
+type FormMismatch struct {
+	Category  string // 复数类别，为空表示非复数翻译的文本
+	Text      string // 翻译的文本
+	Reference string // 参考翻译对应形式的文本
+	PlaceholderMismatch
+}
+
+// CompareFormPlaceholders 比较翻译与参考翻译的占位符，复数翻译的每个复数形式与参考翻译的同一复数类别比较，
+// 参考翻译没有该类别或不是复数时与其文本（other 形式）比较，按复数类别的顺序返回不一致的形式
+func CompareFormPlaceholders(reference string, referencePlurals map[string]string, text string, plurals map[string]string) []FormMismatch {
+	if len(plurals) == 0 {
+		if m := ComparePlaceholders(reference, text); !m.IsEmpty() {
+			return []FormMismatch{{Text: text, Reference: reference, PlaceholderMismatch: m}}
+		}
+		return nil
+	}
+	var list []FormMismatch
+	for _, category := range pluralCategoryOrder {
+		form, ok := plurals[category]
+		if !ok {
+			continue
+		}
+		want, ok := referencePlurals[category]
+		if !ok {
+			want = reference
+		}
+		if m := ComparePlaceholders(want, form); !m.IsEmpty() {
+			list = append(list, FormMismatch{Category: category, Text: form, Reference: want, PlaceholderMismatch: m})
+		}
+	}
+	return list
+}
+
+// checkPlaceholders 校验翻译及其复数形式与默认语言翻译对应形式的占位符是否一致
+func checkPlaceholders(key string, lang, reference entity.CulturesResourceLangs) error {
+	list := CompareFormPlaceholders(reference.Text, reference.Plurals, lang.Text, lang.Plurals)
+	if len(list) == 0 {
+		return nil
+	}
+	if m := list[0]; m.Category != "" {
+		return fmt.Errorf("%w: key %s culture %d plural %s: %s", ErrPlaceholderMismatch, key, lang.CultureID, m.Category, m.PlaceholderMismatch)
+	}
+	return fmt.Errorf("%w: key %s culture %d: %s", ErrPlaceholderMismatch, key, lang.CultureID, list[0].PlaceholderMismatch)
+}
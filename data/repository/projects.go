@@ -0,0 +1,268 @@
+// repository/projects.go
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"i18n-service/config"
+	"i18n-service/data/entity"
+	"sync"
+
+	"xorm.io/builder"
+	"xorm.io/xorm"
+)
+
+// DefaultProjectID 默认项目ID，未指定项目的请求及区分项目前的数据都属于默认项目
+const DefaultProjectID int32 = 0
+
+// ErrProjectNotFound 项目不存在
+var ErrProjectNotFound = errors.New("culture project not exists")
+
+// ErrProjectInUse 项目下仍有资源类型或资源键
+var ErrProjectInUse = errors.New("culture project still has types or keys")
+
+// ErrTypeNotFound 资源类型不存在或不属于当前项目
+var ErrTypeNotFound = errors.New("culture type not exists")
+
+// repositoryStore 所有项目共享的数据库连接、配置及各项目的缓存和变更流
+type repositoryStore struct {
+	sync.RWMutex
+	db          *xorm.Engine
+	cacheConfig config.CacheConfig
+	trash       config.TrashConfig
+	scopes      map[int32]*projectScope
+	projects    map[string]entity.CulturesProjects // 项目代码 -> 项目，nil 表示未加载
+	projectsGen uint64                             // 项目变更时递增，加载开始后发生变更的结果不会写入
+	stop        chan struct{}                      // 关闭后停止回收站的定期清理
+	stopOnce    sync.Once
+}
+
+// projectScope 单个项目的资源缓存和变更流
+type projectScope struct {
+	cache *ResourceCache
+	feed  *ChangeFeed
+}
+
+// scope 获取项目的缓存和变更流，不存在时创建
+func (st *repositoryStore) scope(project int32) *projectScope {
+	st.RLock()
+	s, ok := st.scopes[project]
+	st.RUnlock()
+	if ok {
+		return s
+	}
+	st.Lock()
+	defer st.Unlock()
+	if s, ok := st.scopes[project]; ok {
+		return s
+	}
+	s = &projectScope{cache: NewResourceCache(st.cacheConfig), feed: NewChangeFeed()}
+	st.scopes[project] = s
+	return s
+}
+
+// eachScope 遍历所有已创建的项目范围，调用方需持有锁
+func (st *repositoryStore) eachScope(fn func(*projectScope)) {
+	for _, s := range st.scopes {
+		fn(s)
+	}
+}
+
+// resetScopes 清空所有项目的缓存，并通知订阅方重新获取全量快照，用于语言本身的变更
+func (st *repositoryStore) resetScopes(cultureID int32) {
+	st.RLock()
+	defer st.RUnlock()
+	st.eachScope(func(s *projectScope) {
+		s.cache.Invalidate()
+		s.feed.Publish(ResourceChange{Type: ChangeReset, CultureID: cultureID})
+	})
+}
+
+// 获取指定项目范围的仓库，共享数据库连接，缓存和变更流按项目隔离
+func (r *CulturesRepositoryImpl) WithProject(projectID int32) CulturesRepository {
+	s := r.scope(projectID)
+	return &CulturesRepositoryImpl{
+		repositoryStore: r.repositoryStore,
+		project:         projectID,
+		cache:           s.cache,
+		feed:            s.feed,
+	}
+}
+
+// projectKeyCondition 生成只匹配当前项目资源键的条件，column 为资源键ID列
+func (r *CulturesRepositoryImpl) projectKeyCondition(column string) builder.Cond {
+	sub := builder.Select("id").
+		From(r.db.TableName(&entity.CulturesResourceKeys{})).
+		Where(builder.Eq{"project_id": r.project})
+	return builder.In(column, sub)
+}
+
+// checkProjectType 校验资源类型属于当前项目，0 表示未指定资源类型
+func (r *CulturesRepositoryImpl) checkProjectType(s *xorm.Session, typeID int32) error {
+	if typeID == 0 {
+		return nil
+	}
+	exists, err := s.ID(typeID).Where("project_id = ?", r.project).Exist(&entity.CulturesResourceTypes{})
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("%w: %d", ErrTypeNotFound, typeID)
+	}
+	return nil
+}
+
+// projectCultureIds 获取当前项目启用的语言ID，为空表示启用全部语言
+func (r *CulturesRepositoryImpl) projectCultureIds() ([]int32, error) {
+	var links []entity.CulturesProjectCultures
+	if err := r.db.Where("project_id = ?", r.project).Find(&links); err != nil {
+		return nil, err
+	}
+	ids := make([]int32, 0, len(links))
+	for _, v := range links {
+		ids = append(ids, v.CultureID)
+	}
+	return ids, nil
+}
+
+// 根据代码获取项目（读穿透缓存）
+func (r *CulturesRepositoryImpl) GetProjectByCode(code string) (*entity.CulturesProjects, error) {
+	r.RLock()
+	projects, generation := r.projects, r.projectsGen
+	r.RUnlock()
+	if projects == nil {
+		var list []entity.CulturesProjects
+		if err := r.db.Find(&list); err != nil {
+			return nil, err
+		}
+		projects = make(map[string]entity.CulturesProjects, len(list))
+		for _, v := range list {
+			projects[v.Code] = v
+		}
+		r.Lock()
+		if generation == r.projectsGen {
+			r.projects = projects
+		}
+		r.Unlock()
+	}
+	project, ok := projects[code]
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	return &project, nil
+}
+
+// invalidateProjects 清空项目缓存
+func (r *CulturesRepositoryImpl) invalidateProjects() {
+	r.Lock()
+	defer r.Unlock()
+	r.projects = nil
+	r.projectsGen++
+}
+
+// 获取项目分页
+func (r *CulturesRepositoryImpl) GetCulturesProjectPager(index, size int, text string) ([]entity.CulturesProjects, int64, error) {
+	var projects []entity.CulturesProjects
+	sess := r.db.NewSession()
+	defer sess.Close()
+	if text != "" {
+		sess.Where("code like ? or name like ?", "%"+text+"%", "%"+text+"%")
+	}
+	offset := index*size - size
+	total, err := sess.Limit(size, offset).FindAndCount(&projects)
+	return projects, total, err
+}
+
+// 获取项目启用的语言
+func (r *CulturesRepositoryImpl) GetCulturesProjectCultures(projectIds []int32) (map[int32][]int32, error) {
+	data := make(map[int32][]int32)
+	if len(projectIds) == 0 {
+		return data, nil
+	}
+	var links []entity.CulturesProjectCultures
+	if err := r.db.In("project_id", projectIds).Find(&links); err != nil {
+		return nil, err
+	}
+	for _, v := range links {
+		data[v.ProjectID] = append(data[v.ProjectID], v.CultureID)
+	}
+	return data, nil
+}
+
+// 添加或更新项目，整体替换项目启用的语言
+func (r *CulturesRepositoryImpl) AddOrUpdateCulturesProject(data entity.CulturesProjects, cultureIds []int32) (*entity.CulturesProjects, error) {
+	source := entity.CulturesProjects{Code: data.Code}
+	has, err := r.db.Get(&source)
+	if err != nil {
+		return nil, err
+	}
+	if has && source.ID != data.ID {
+		return &source, errors.New("culture project already exists")
+	}
+	_, err = r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		if data.ID > 0 {
+			if _, ex := s.ID(data.ID).Update(&data); ex != nil {
+				return nil, ex
+			}
+		} else if _, ex := s.Insert(&data); ex != nil {
+			return nil, ex
+		}
+		if _, ex := s.Where("project_id = ?", data.ID).Delete(&entity.CulturesProjectCultures{}); ex != nil {
+			return nil, ex
+		}
+		seen := make(map[int32]bool, len(cultureIds))
+		for _, id := range cultureIds {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			if _, ex := s.Insert(&entity.CulturesProjectCultures{ProjectID: data.ID, CultureID: id}); ex != nil {
+				return nil, ex
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return &data, uniqueError(err, "culture project already exists")
+	}
+	r.invalidateProjects()
+	// 启用的语言变化会影响语言列表和回退链
+	scope := r.scope(data.ID)
+	scope.cache.Invalidate()
+	scope.feed.Publish(ResourceChange{Type: ChangeReset})
+	return &data, nil
+}
+
+// 删除项目及其启用的语言和标签，项目下仍有资源类型或资源键（包括回收站中的）时拒绝删除
+func (r *CulturesRepositoryImpl) DeleteCulturesProject(id int32) error {
+	_, err := r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		keys, err := s.Unscoped().Where("project_id = ?", id).Count(&entity.CulturesResourceKeys{})
+		if err != nil {
+			return nil, err
+		}
+		types, err := s.Unscoped().Where("project_id = ?", id).Count(&entity.CulturesResourceTypes{})
+		if err != nil {
+			return nil, err
+		}
+		if keys > 0 || types > 0 {
+			return nil, ErrProjectInUse
+		}
+		if _, err := s.Where("project_id = ?", id).Delete(&entity.CulturesProjectCultures{}); err != nil {
+			return nil, err
+		}
+		// 标签属于项目，随项目一并删除
+		tagIds := builder.Select("id").From(r.db.TableName(&entity.CulturesResourceTags{})).Where(builder.Eq{"project_id": id})
+		if _, err := s.Where(builder.In("tag_id", tagIds)).Delete(&entity.CulturesResourceKeyTags{}); err != nil {
+			return nil, err
+		}
+		if _, err := s.Where("project_id = ?", id).Delete(&entity.CulturesResourceTags{}); err != nil {
+			return nil, err
+		}
+		_, err = s.ID(id).Delete(&entity.CulturesProjects{})
+		return nil, err
+	})
+	if err == nil {
+		r.invalidateProjects()
+	}
+	return err
+}
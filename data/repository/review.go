@@ -0,0 +1,65 @@
+// repository/review.go
+package repository
+
+import (
+	"errors"
+	"fmt"
+)
+
+// 翻译审核状态，取值与 proto.TranslationStatuses 一致
+const (
+	StatusDraft       int32 = iota // 草稿
+	StatusNeedsReview              // 待审核
+	StatusApproved                 // 已通过
+	StatusRejected                 // 已驳回
+)
+
+// ErrInvalidTransition 审核状态流转不合法
+var ErrInvalidTransition = errors.New("invalid status transition")
+
+// statusTransitions 允许的审核状态流转，已通过的翻译可以撤回重新审核
+var statusTransitions = map[int32][]int32{
+	StatusDraft:       {StatusNeedsReview},
+	StatusNeedsReview: {StatusApproved, StatusRejected, StatusDraft},
+	StatusApproved:    {StatusNeedsReview},
+	StatusRejected:    {StatusDraft, StatusNeedsReview},
+}
+
+// IsValidStatus 判断审核状态是否有效
+func IsValidStatus(status int32) bool {
+	_, ok := statusTransitions[status]
+	return ok
+}
+
+// CheckTransition 校验审核状态能否从 from 流转到 to
+func CheckTransition(from, to int32) error {
+	for _, v := range statusTransitions[from] {
+		if v == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w from %d to %d", ErrInvalidTransition, from, to)
+}
+
+// editStatus 新增或修改的翻译保存的审核状态，只能为草稿或待审核，审核通过或驳回只能通过状态流转
+func editStatus(status int32) int32 {
+	if status == StatusDraft {
+		return StatusDraft
+	}
+	return StatusNeedsReview
+}
+
+// isReviewed 流转到该状态时是否记录审核人和审核时间
+func isReviewed(status int32) bool {
+	return status == StatusApproved || status == StatusRejected
+}
+
+// Visible 判断资源键在该语言下的翻译是否对客户端可见。
+// 预览时未被驳回的翻译均可见，否则只有审核通过的翻译可见。
+func (s *CultureSnapshot) Visible(keyID int32, preview bool) bool {
+	status := s.Statuses[keyID]
+	if preview {
+		return status != StatusRejected
+	}
+	return status == StatusApproved
+}
@@ -0,0 +1,68 @@
+// repository/tags.go
+package repository
+
+import (
+	"errors"
+	"i18n-service/data/entity"
+	"strings"
+
+	"xorm.io/builder"
+	"xorm.io/xorm"
+)
+
+// 标签名称的最大长度
+const maxTagLength = 50
+
+// normalizeTags 去除标签名称两端的空白并去重，存在空标签或超长标签时返回错误
+func normalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	var names []string
+	for _, v := range tags {
+		name := strings.TrimSpace(v)
+		if name == "" {
+			return nil, errors.New("tag name is empty")
+		}
+		if len(name) > maxTagLength {
+			return nil, errors.New("tag name is too long: " + name)
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// tagKeyCondition 生成按当前项目的标签过滤资源键的条件，column 为资源键ID列，资源键带有任意一个标签即匹配
+func (r *CulturesRepositoryImpl) tagKeyCondition(column string, tags []string) builder.Cond {
+	tagTable := r.db.TableName(&entity.CulturesResourceTags{})
+	keyTagTable := r.db.TableName(&entity.CulturesResourceKeyTags{})
+	sub := builder.Select("kt.key_id").
+		From(keyTagTable, "kt").
+		Join("INNER", tagTable+" t", "t.id = kt.tag_id").
+		Where(builder.Eq{"t.project_id": r.project}.And(builder.In("t.name", tags)))
+	return builder.In(column, sub)
+}
+
+// ensureTags 按名称获取当前项目的标签，不存在的标签自动创建
+func (r *CulturesRepositoryImpl) ensureTags(s *xorm.Session, names []string) ([]entity.CulturesResourceTags, error) {
+	var tags []entity.CulturesResourceTags
+	if err := s.Where("project_id = ?", r.project).In("name", names).Find(&tags); err != nil {
+		return nil, err
+	}
+	exists := make(map[string]bool, len(tags))
+	for _, v := range tags {
+		exists[v.Name] = true
+	}
+	for _, name := range names {
+		if exists[name] {
+			continue
+		}
+		tag := entity.CulturesResourceTags{ProjectID: r.project, Name: name}
+		if _, err := s.Insert(&tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
@@ -0,0 +1,200 @@
+// repository/transfer.go
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"i18n-service/data/entity"
+	"maps"
+
+	"xorm.io/xorm"
+)
+
+// ImportEntry 导入的资源项
+type ImportEntry struct {
+	Key  entity.CulturesResourceKeys   // 资源键，按名称匹配，描述、上下文说明和最大字符数为空时不修改已有的值
+	Type string                        // 资源类型名称，为空时新资源键使用默认资源类型，已有资源键不修改类型
+	Lang *entity.CulturesResourceLangs // 翻译，为 nil 时只导入资源键
+}
+
+// ImportResult 导入结果
+type ImportResult struct {
+	KeysAdded    int // 新增的资源键数
+	KeysUpdated  int // 修改的资源键数
+	LangsAdded   int // 新增的翻译数
+	LangsUpdated int // 修改的翻译数
+	Skipped      int // 与已有数据相同而跳过的翻译数
+}
+
+// 获取符合条件的资源键，按名称排序
+func (r *CulturesRepositoryImpl) GetCulturesResourceKeyList(filter ResourceKeyFilter) ([]entity.CulturesResourceKeys, error) {
+	names, err := r.GetCulturesResourceKeysByFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	var keys []entity.CulturesResourceKeys
+	if len(names) == 0 {
+		return keys, nil
+	}
+	ids := make([]int32, 0, len(names))
+	for id := range names {
+		ids = append(ids, id)
+	}
+	err = r.db.In("id", ids).Where("project_id = ?", r.project).Asc("name").Find(&keys)
+	return keys, err
+}
+
+// 导入资源键及其在指定语言的翻译。资源键按名称新增或更新，翻译按资源键和语言新增或更新，
+// 不存在的资源类型自动创建。所有资源项在同一事务中导入，任一资源项校验失败时不做任何修改。
+func (r *CulturesRepositoryImpl) ImportCulturesResources(ctx context.Context, cultureID int32, typeID int32, entries []ImportEntry) (*ImportResult, error) {
+	result := &ImportResult{}
+	_, err := r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		if err := r.checkProjectType(s, typeID); err != nil {
+			return nil, err
+		}
+		types := make(map[string]int32)
+		for _, v := range entries {
+			key, err := r.importKey(ctx, s, v, typeID, types, result)
+			if err != nil {
+				return nil, err
+			}
+			if v.Lang == nil {
+				continue
+			}
+			lang := *v.Lang
+			lang.KeyID, lang.CultureID = key.ID, cultureID
+			if err := r.importLang(ctx, s, key, lang, result); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, uniqueError(err, "culture key already exists")
+	}
+	// 导入可能涉及大量资源，通知订阅方重新获取全量快照
+	r.cache.Invalidate()
+	r.feed.Publish(ResourceChange{Type: ChangeReset, CultureID: cultureID})
+	return result, nil
+}
+
+// importKey 按名称新增或更新资源键，types 缓存资源类型名称到ID的映射
+func (r *CulturesRepositoryImpl) importKey(ctx context.Context, s *xorm.Session, v ImportEntry, typeID int32, types map[string]int32, result *ImportResult) (entity.CulturesResourceKeys, error) {
+	if v.Key.Name == "" {
+		return v.Key, errors.New("culture key name is empty")
+	}
+	if v.Type != "" {
+		id, err := r.importType(ctx, s, v.Type, types)
+		if err != nil {
+			return v.Key, err
+		}
+		typeID = id
+	}
+	old := entity.CulturesResourceKeys{}
+	has, err := s.Where("project_id = ? and name = ?", r.project, v.Key.Name).Get(&old)
+	if err != nil {
+		return old, err
+	}
+	if !has {
+		key := v.Key
+		key.ID, key.ProjectID, key.TypeID = 0, r.project, typeID
+		if _, err := s.Insert(&key); err != nil {
+			return key, err
+		}
+		result.KeysAdded++
+		return key, r.recordHistory(ctx, s, HistoryEntityKey, int64(key.ID), key.ID, 0, nil, key)
+	}
+	current := old
+	if v.Type != "" {
+		current.TypeID = typeID
+	}
+	if v.Key.Description != "" {
+		current.Description = v.Key.Description
+	}
+	if v.Key.Context != "" {
+		current.Context = v.Key.Context
+	}
+	if v.Key.MaxLength > 0 {
+		current.MaxLength = v.Key.MaxLength
+	}
+	if current.TypeID == old.TypeID && current.Description == old.Description &&
+		current.Context == old.Context && current.MaxLength == old.MaxLength {
+		return old, nil
+	}
+	if _, err := s.ID(old.ID).Cols("type_id", "description", "context", "max_length").Update(&current); err != nil {
+		return old, err
+	}
+	result.KeysUpdated++
+	return current, r.recordHistory(ctx, s, HistoryEntityKey, int64(old.ID), old.ID, 0, old, current)
+}
+
+// importType 获取资源类型ID，不存在时创建
+func (r *CulturesRepositoryImpl) importType(ctx context.Context, s *xorm.Session, name string, types map[string]int32) (int32, error) {
+	if id, ok := types[name]; ok {
+		return id, nil
+	}
+	data := entity.CulturesResourceTypes{}
+	has, err := s.Where("project_id = ? and name = ?", r.project, name).Get(&data)
+	if err != nil {
+		return 0, err
+	}
+	if !has {
+		data = entity.CulturesResourceTypes{ProjectID: r.project, Name: name}
+		if _, err := s.Insert(&data); err != nil {
+			return 0, uniqueError(err, "culture type already exists")
+		}
+		if err := r.recordHistory(ctx, s, HistoryEntityType, int64(data.ID), 0, 0, nil, data); err != nil {
+			return 0, err
+		}
+	}
+	types[name] = data.ID
+	return data.ID, nil
+}
+
+// importLang 校验并新增或更新翻译，文本和复数形式都未变化时跳过。
+// 新增或修改的翻译按草稿或待审核保存并清除审核人
+func (r *CulturesRepositoryImpl) importLang(ctx context.Context, s *xorm.Session, key entity.CulturesResourceKeys, lang entity.CulturesResourceLangs, result *ImportResult) error {
+	lang.Status, lang.Reviewer, lang.ReviewedAt = editStatus(lang.Status), "", nil
+	if err := checkTextLength(key, lang); err != nil {
+		return err
+	}
+	if err := r.checkLangsPlaceholders(s, key.Name, key.ID, []entity.CulturesResourceLangs{lang}); err != nil {
+		return err
+	}
+	old := entity.CulturesResourceLangs{}
+	has, err := s.Where("key_id = ? and culture_id = ?", lang.KeyID, lang.CultureID).Get(&old)
+	if err != nil {
+		return err
+	}
+	if !has {
+		if _, err := s.Insert(&lang); err != nil {
+			return err
+		}
+		if err := savePlurals(s, lang); err != nil {
+			return err
+		}
+		result.LangsAdded++
+		return r.recordHistory(ctx, s, HistoryEntityLang, lang.ID, lang.KeyID, lang.CultureID, nil, lang)
+	}
+	if old.Plurals, err = loadPlurals(s, old.KeyID, old.CultureID); err != nil {
+		return err
+	}
+	if old.Text == lang.Text && maps.Equal(old.Plurals, lang.Plurals) {
+		result.Skipped++
+		return nil
+	}
+	lang.ID = old.ID
+	if _, err := s.ID(old.ID).MustCols("text", "status", "reviewer", "reviewed_at").Update(&lang); err != nil {
+		return fmt.Errorf("key %s: %w", key.Name, err)
+	}
+	if lang.Plurals == nil && old.Plurals != nil {
+		// 导入的翻译没有复数形式时清除已有的复数形式
+		lang.Plurals = map[string]string{}
+	}
+	if err := savePlurals(s, lang); err != nil {
+		return err
+	}
+	result.LangsUpdated++
+	return r.recordHistory(ctx, s, HistoryEntityLang, lang.ID, lang.KeyID, lang.CultureID, old, lang)
+}
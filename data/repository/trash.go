@@ -0,0 +1,110 @@
+// repository/trash.go
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"i18n-service/config"
+	"i18n-service/data/entity"
+	"log"
+	"time"
+
+	"xorm.io/xorm"
+)
+
+var trashConfigKey = "I18nTrash"
+
+// 默认回收站配置
+var defaultTrashConfig = config.TrashConfig{
+	RetentionDays: 30,
+	PurgeInterval: 3600,
+}
+
+// 软删除的数据 deleted_at 为删除时间，未删除的数据为空或零值
+var deletedEpoch = time.Unix(0, 0)
+
+// ErrTypeInUse 资源类型仍被资源键引用
+var ErrTypeInUse = errors.New("culture type is still referenced by keys")
+
+// parseTrashConfig 解析回收站配置，为空或解析失败时使用默认配置
+func parseTrashConfig(str string) config.TrashConfig {
+	cfg := defaultTrashConfig
+	if str == "" {
+		return cfg
+	}
+	if err := json.Unmarshal([]byte(str), &cfg); err != nil {
+		return defaultTrashConfig
+	}
+	if cfg.PurgeInterval <= 0 {
+		cfg.PurgeInterval = defaultTrashConfig.PurgeInterval
+	}
+	return cfg
+}
+
+// trashConfig 获取当前的回收站配置
+func (r *CulturesRepositoryImpl) trashConfig() config.TrashConfig {
+	r.RLock()
+	defer r.RUnlock()
+	return r.trash
+}
+
+// 获取回收站数据的保留时长，0 表示不清理
+func (r *CulturesRepositoryImpl) GetTrashRetention() time.Duration {
+	return time.Duration(r.trashConfig().RetentionDays) * 24 * time.Hour
+}
+
+// purgeLoop 定期永久删除超过保留期的软删除数据，仓库关闭后退出
+func (r *CulturesRepositoryImpl) purgeLoop() {
+	for {
+		cfg := r.trashConfig()
+		select {
+		case <-r.stop:
+			return
+		case <-time.After(time.Duration(cfg.PurgeInterval) * time.Second):
+		}
+		if cfg.RetentionDays <= 0 {
+			continue
+		}
+		if err := r.purgeTrash(time.Now().AddDate(0, 0, -cfg.RetentionDays)); err != nil {
+			log.Printf("purge trash error: %v", err)
+		}
+	}
+}
+
+// 停止回收站的定期清理并关闭数据库连接
+func (r *CulturesRepositoryImpl) Close() error {
+	r.stopOnce.Do(func() { close(r.stop) })
+	return r.db.Close()
+}
+
+// purgeTrash 永久删除 before 之前软删除的资源键及其语言、复数形式、标签关联和资源类型，
+// 先删除引用资源键的数据以满足外键约束
+func (r *CulturesRepositoryImpl) purgeTrash(before time.Time) error {
+	_, err := r.db.Transaction(func(s *xorm.Session) (interface{}, error) {
+		var keys []entity.CulturesResourceKeys
+		if err := s.Unscoped().Where("deleted_at > ? and deleted_at < ?", deletedEpoch, before).Find(&keys); err != nil {
+			return nil, err
+		}
+		if len(keys) > 0 {
+			ids := make([]int32, 0, len(keys))
+			for _, v := range keys {
+				ids = append(ids, v.ID)
+			}
+			if _, err := s.In("key_id", ids).Delete(&entity.CulturesResourcePlurals{}); err != nil {
+				return nil, err
+			}
+			if _, err := s.Unscoped().In("key_id", ids).Delete(&entity.CulturesResourceLangs{}); err != nil {
+				return nil, err
+			}
+			if _, err := s.In("key_id", ids).Delete(&entity.CulturesResourceKeyTags{}); err != nil {
+				return nil, err
+			}
+			if _, err := s.Unscoped().In("id", ids).Delete(&entity.CulturesResourceKeys{}); err != nil {
+				return nil, err
+			}
+		}
+		_, err := s.Unscoped().Where("deleted_at > ? and deleted_at < ?", deletedEpoch, before).Delete(&entity.CulturesResourceTypes{})
+		return nil, err
+	})
+	return err
+}
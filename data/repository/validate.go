@@ -0,0 +1,86 @@
+// repository/validate.go
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"i18n-service/data/entity"
+	"unicode/utf8"
+
+	"xorm.io/xorm"
+)
+
+// ErrTextTooLong 翻译超过资源键的最大字符数
+var ErrTextTooLong = errors.New("text exceeds max length")
+
+// checkTextLength 校验翻译及其复数形式是否超过资源键的最大字符数，按 Unicode 字符计数
+func checkTextLength(key entity.CulturesResourceKeys, data entity.CulturesResourceLangs) error {
+	if key.MaxLength <= 0 {
+		return nil
+	}
+	if n := utf8.RuneCountInString(data.Text); n > int(key.MaxLength) {
+		return fmt.Errorf("%w: key %s allows %d characters, got %d", ErrTextTooLong, key.Name, key.MaxLength, n)
+	}
+	for category, text := range data.Plurals {
+		if n := utf8.RuneCountInString(text); n > int(key.MaxLength) {
+			return fmt.Errorf("%w: key %s allows %d characters, got %d in plural %s", ErrTextTooLong, key.Name, key.MaxLength, n, category)
+		}
+	}
+	return nil
+}
+
+// validateLang 读取资源语言所属的资源键，校验翻译长度及占位符与默认语言的翻译一致，资源键不属于当前项目时返回错误
+func (r *CulturesRepositoryImpl) validateLang(s *xorm.Session, data entity.CulturesResourceLangs) error {
+	key := entity.CulturesResourceKeys{}
+	has, err := s.ID(data.KeyID).Where("project_id = ?", r.project).Get(&key)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errors.New("culture key not exists")
+	}
+	if err := checkTextLength(key, data); err != nil {
+		return err
+	}
+	return r.checkLangsPlaceholders(s, key.Name, key.ID, []entity.CulturesResourceLangs{data})
+}
+
+// checkLangsPlaceholders 校验资源键的翻译及其复数形式与默认语言翻译对应形式的占位符一致。
+// 默认语言的翻译优先使用本次写入的，否则读取已有的；默认语言尚无翻译时不校验。
+func (r *CulturesRepositoryImpl) checkLangsPlaceholders(s *xorm.Session, key string, keyID int32, langs []entity.CulturesResourceLangs) error {
+	culture := entity.CulturesResources{}
+	has, err := s.Where("is_default = ?", true).Get(&culture)
+	if err != nil || !has {
+		return err
+	}
+	var reference entity.CulturesResourceLangs
+	found := false
+	for _, v := range langs {
+		if v.CultureID == culture.ID {
+			reference, found = v, true
+			break
+		}
+	}
+	if !found && keyID > 0 {
+		if found, err = s.Where("key_id = ? and culture_id = ?", keyID, culture.ID).Get(&reference); err != nil {
+			return err
+		}
+		if found {
+			if reference.Plurals, err = loadPlurals(s, keyID, culture.ID); err != nil {
+				return err
+			}
+		}
+	}
+	if !found {
+		return nil
+	}
+	for _, v := range langs {
+		if v.CultureID == culture.ID {
+			continue
+		}
+		if err := checkPlaceholders(key, v, reference); err != nil {
+			return err
+		}
+	}
+	return nil
+}
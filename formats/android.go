@@ -0,0 +1,265 @@
+// formats/android.go
+package formats
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"i18n-service/msgformat"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// AndroidValuesDir 获取语言的 Android 资源目录，例如 values-de、values-pt-rBR，
+// 包含文字或变体的语言使用 BCP 47 形式，例如 values-b+zh+Hans
+func AndroidValuesDir(culture string) string {
+	tag, err := language.Parse(culture)
+	if err != nil {
+		return "values-" + culture
+	}
+	base, script, region := tag.Raw()
+	if script.String() != "Zzzz" || len(tag.Variants()) > 0 {
+		return "values-b+" + strings.ReplaceAll(tag.String(), "-", "+")
+	}
+	if region.String() != "ZZ" {
+		return "values-" + base.String() + "-r" + region.String()
+	}
+	return "values-" + base.String()
+}
+
+// WriteAndroid 将语言目录写出为 Android strings.xml，只写出已翻译且审核通过的资源项，待定的翻译运行时回退到默认语言。
+// 资源名称为资源键名称，描述写在资源前的注释中，复数资源写为 plurals，按语言的复数类别写出各复数形式。
+func WriteAndroid(w io.Writer, c *Catalog) error {
+	b := bufio.NewWriter(w)
+	b.WriteString(xml.Header)
+	b.WriteString("<resources>\n")
+	for _, e := range c.Entries {
+		if e.Fuzzy || (e.Text == "" && len(e.Plurals) == 0) {
+			continue
+		}
+		if e.Description != "" {
+			b.WriteString("    <!-- " + xmlComment(e.Description) + " -->\n")
+		}
+		if !e.Plural {
+			b.WriteString(`    <string name="` + xmlEscape(e.Key) + `">` + xmlEscapeText(androidEscape(e.Text)) + "</string>\n")
+			continue
+		}
+		b.WriteString(`    <plurals name="` + xmlEscape(e.Key) + "\">\n")
+		for _, category := range msgformat.PluralCategories(c.pluralLocale()) {
+			text := pluralText(e.Plurals, category, e.Text)
+			b.WriteString(`        <item quantity="` + category + `">` + xmlEscapeText(androidEscape(text)) + "</item>\n")
+		}
+		b.WriteString("    </plurals>\n")
+	}
+	b.WriteString("</resources>\n")
+	return b.Flush()
+}
+
+// androidEscape 转义 Android 字符串资源中的特殊字符。
+// 撇号、引号和反斜杠需要转义，开头的 @ 和 ? 会被视为资源引用，首尾空白或连续空白会被合并，此时整体用引号包围。
+func androidEscape(s string) string {
+	var sb strings.Builder
+	for i, r := range s {
+		switch {
+		case r == '\\' || r == '\'' || r == '"':
+			sb.WriteRune('\\')
+			sb.WriteRune(r)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case i == 0 && (r == '@' || r == '?'):
+			sb.WriteRune('\\')
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	if strings.TrimSpace(s) != s || strings.Contains(s, "  ") {
+		return `"` + sb.String() + `"`
+	}
+	return sb.String()
+}
+
+// androidUnescape 按 aapt 的规则还原字符串资源：处理反斜杠转义，引号外的连续空白合并为一个空格并去除首尾空白，
+// 引号内的空白原样保留
+func androidUnescape(s string) (string, error) {
+	var sb strings.Builder
+	quoted, space := false, false
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '"':
+			quoted = !quoted
+			continue
+		case !quoted && (r == ' ' || r == '\n' || r == '\t' || r == '\r'):
+			space = sb.Len() > 0
+			continue
+		}
+		if space {
+			sb.WriteRune(' ')
+			space = false
+		}
+		if r != '\\' {
+			sb.WriteRune(r)
+			continue
+		}
+		if i++; i == len(runes) {
+			return "", fmt.Errorf("trailing backslash")
+		}
+		switch runes[i] {
+		case 'n':
+			sb.WriteRune('\n')
+		case 't':
+			sb.WriteRune('\t')
+		case 'u':
+			if i+4 >= len(runes) {
+				return "", fmt.Errorf("invalid unicode escape")
+			}
+			n, err := strconv.ParseUint(string(runes[i+1:i+5]), 16, 32)
+			if err != nil {
+				return "", fmt.Errorf("invalid unicode escape \\u%s", string(runes[i+1:i+5]))
+			}
+			sb.WriteRune(rune(n))
+			i += 4
+		default:
+			sb.WriteRune(runes[i])
+		}
+	}
+	if quoted {
+		return "", fmt.Errorf("unterminated quote")
+	}
+	return sb.String(), nil
+}
+
+// androidString <string> 元素
+type androidString struct {
+	Name         string `xml:"name,attr"`
+	Translatable string `xml:"translatable,attr"`
+	xliffText
+}
+
+// androidPlurals <plurals> 元素
+type androidPlurals struct {
+	Name  string `xml:"name,attr"`
+	Items []struct {
+		Quantity string `xml:"quantity,attr"`
+		xliffText
+	} `xml:"item"`
+}
+
+// ParseAndroid 解析 Android strings.xml。string 和 plurals 资源转换为资源项，紧邻资源前的注释作为描述，
+// 不可翻译的资源及 string-array 等其他资源忽略。文件中没有语言信息，语言由调用方指定。
+func ParseAndroid(r io.Reader) (*Catalog, error) {
+	c := &Catalog{}
+	d := xml.NewDecoder(r)
+	depth := 0
+	comment := ""
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+		}
+		switch t := tok.(type) {
+		case xml.Comment:
+			if depth == 1 {
+				comment = strings.TrimSpace(string(t))
+			}
+		case xml.EndElement:
+			depth--
+		case xml.StartElement:
+			if depth == 0 {
+				if t.Name.Local != "resources" {
+					return nil, fmt.Errorf("%w: unexpected root element %s", ErrSyntax, t.Name.Local)
+				}
+				depth++
+				continue
+			}
+			if err := c.androidResource(d, t, comment); err != nil {
+				return nil, err
+			}
+			comment = ""
+		}
+	}
+	return c, nil
+}
+
+// androidResource 解析 resources 下的一个资源元素
+func (c *Catalog) androidResource(d *xml.Decoder, start xml.StartElement, description string) error {
+	switch start.Name.Local {
+	case "string":
+		var s androidString
+		if err := d.DecodeElement(&s, &start); err != nil {
+			return fmt.Errorf("%w: %v", ErrSyntax, err)
+		}
+		if s.Translatable == "false" {
+			return nil
+		}
+		text, err := androidText(s.Name, s.xliffText)
+		if err != nil {
+			return err
+		}
+		c.Entries = append(c.Entries, Entry{Key: s.Name, Description: description, Text: text})
+	case "plurals":
+		var p androidPlurals
+		if err := d.DecodeElement(&p, &start); err != nil {
+			return fmt.Errorf("%w: %v", ErrSyntax, err)
+		}
+		e := Entry{Key: p.Name, Description: description, Plural: true, Plurals: make(map[string]string)}
+		for _, item := range p.Items {
+			if !msgformat.IsPluralCategory(item.Quantity) {
+				return fmt.Errorf("%w: plurals %s: invalid quantity %q", ErrSyntax, p.Name, item.Quantity)
+			}
+			text, err := androidText(p.Name, item.xliffText)
+			if err != nil {
+				return err
+			}
+			e.Plurals[item.Quantity] = text
+		}
+		e.Text = pluralText(e.Plurals, "other", "")
+		c.Entries = append(c.Entries, e)
+	default:
+		return d.Skip()
+	}
+	return nil
+}
+
+// androidText 获取资源的文本，不支持 xliff:g 等行内标记
+func androidText(name string, t xliffText) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("%w: resource without name", ErrSyntax)
+	}
+	if len(t.Inline) > 0 {
+		return "", fmt.Errorf("%w: resource %s: inline markup <%s> is not supported", ErrSyntax, name, t.Inline[0].XMLName.Local)
+	}
+	text, err := androidUnescape(t.Text)
+	if err != nil {
+		return "", fmt.Errorf("%w: resource %s: %v", ErrSyntax, name, err)
+	}
+	return text, nil
+}
+
+// xmlEscape 转义 XML 文本和双引号包围的属性值，不转义撇号以保持 Android 转义的可读性
+var xmlEscape = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;").Replace
+
+// xmlEscapeText 转义 XML 文本，引号原样保留
+var xmlEscapeText = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace
+
+// xmlComment 将文本转换为 XML 注释内容，注释中不能包含 --，也不能以 - 结尾。
+// 替换一次后 --- 仍包含 --，需要重复替换
+func xmlComment(s string) string {
+	for strings.Contains(s, "--") {
+		s = strings.ReplaceAll(s, "--", "- -")
+	}
+	if strings.HasSuffix(s, "-") {
+		s += " "
+	}
+	return s
+}
@@ -0,0 +1,368 @@
+// formats/apple.go
+package formats
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"i18n-service/msgformat"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	textunicode "golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// Xcode 为没有注释的字符串生成的默认注释，导入时不作为描述
+const appleNoComment = "No comment provided by engineer."
+
+// stringsdict 中复数规则的键
+const (
+	appleFormatKey    = "NSStringLocalizedFormatKey"
+	appleSpecTypeKey  = "NSStringFormatSpecTypeKey"
+	appleValueTypeKey = "NSStringFormatValueTypeKey"
+	applePluralRule   = "NSStringPluralRuleType"
+	applePluralVar    = "count" // 导出时使用的复数变量名称
+)
+
+// appleVariable stringsdict 格式字符串中的变量引用，例如 %#@count@
+var appleVariable = regexp.MustCompile(`%#@([^@]+)@`)
+
+// WriteAppleStrings 将语言目录写出为 iOS Localizable.strings，只写出已翻译且审核通过的非复数资源项，
+// 复数资源由 WriteAppleStringsdict 写出。描述写在资源前的注释中。
+func WriteAppleStrings(w io.Writer, c *Catalog) error {
+	b := bufio.NewWriter(w)
+	for _, e := range c.Entries {
+		if e.Plural || e.Fuzzy || e.Text == "" {
+			continue
+		}
+		if e.Description != "" {
+			b.WriteString("/* " + strings.ReplaceAll(e.Description, "*/", "* /") + " */\n")
+		}
+		b.WriteString(appleQuote(e.Key) + " = " + appleQuote(e.Text) + ";\n\n")
+	}
+	return b.Flush()
+}
+
+// appleQuote 将字符串转换为 .strings 的带引号字符串
+func appleQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// WriteAppleStringsdict 将语言目录写出为 iOS Localizable.stringsdict，只写出已翻译且审核通过的复数资源项。
+// 每个资源的格式字符串为 %#@count@，按语言的复数类别写出各复数形式，描述写在资源前的注释中。
+func WriteAppleStringsdict(w io.Writer, c *Catalog) error {
+	b := bufio.NewWriter(w)
+	b.WriteString(xml.Header)
+	b.WriteString(`<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">` + "\n")
+	b.WriteString("<plist version=\"1.0\">\n<dict>\n")
+	for _, e := range c.Entries {
+		if !e.Plural || e.Fuzzy || (e.Text == "" && len(e.Plurals) == 0) {
+			continue
+		}
+		if e.Description != "" {
+			b.WriteString("    <!-- " + xmlComment(e.Description) + " -->\n")
+		}
+		b.WriteString("    <key>" + xmlEscape(e.Key) + "</key>\n    <dict>\n")
+		b.WriteString("        <key>" + appleFormatKey + "</key>\n        <string>%#@" + applePluralVar + "@</string>\n")
+		b.WriteString("        <key>" + applePluralVar + "</key>\n        <dict>\n")
+		b.WriteString("            <key>" + appleSpecTypeKey + "</key>\n            <string>" + applePluralRule + "</string>\n")
+		b.WriteString("            <key>" + appleValueTypeKey + "</key>\n            <string>d</string>\n")
+		for _, category := range msgformat.PluralCategories(c.pluralLocale()) {
+			b.WriteString("            <key>" + category + "</key>\n")
+			b.WriteString("            <string>" + xmlEscape(pluralText(e.Plurals, category, e.Text)) + "</string>\n")
+		}
+		b.WriteString("        </dict>\n    </dict>\n")
+	}
+	b.WriteString("</dict>\n</plist>\n")
+	return b.Flush()
+}
+
+// appleReader 按 BOM 识别 UTF-16 编码，旧版 Xcode 生成的 .strings 文件为 UTF-16
+func appleReader(r io.Reader) io.Reader {
+	return transform.NewReader(r, textunicode.BOMOverride(textunicode.UTF8.NewDecoder()))
+}
+
+// ParseAppleStrings 解析 iOS .strings 文件，紧邻资源前的注释作为描述。
+// 文件中没有语言信息，语言由调用方指定。
+func ParseAppleStrings(r io.Reader) (*Catalog, error) {
+	data, err := io.ReadAll(appleReader(r))
+	if err != nil {
+		return nil, err
+	}
+	p := &appleScanner{src: []rune(string(data)), line: 1}
+	c := &Catalog{}
+	for {
+		comment, err := p.skip()
+		if err != nil {
+			return nil, err
+		}
+		if p.eof() {
+			break
+		}
+		key, err := p.string()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.skip(); err != nil {
+			return nil, err
+		}
+		// "key"; 为值与键相同的简写
+		value := key
+		if !p.accept(';') {
+			if !p.accept('=') {
+				return nil, p.errorf("expected '=' after key %q", key)
+			}
+			if _, err := p.skip(); err != nil {
+				return nil, err
+			}
+			if value, err = p.string(); err != nil {
+				return nil, err
+			}
+			if _, err := p.skip(); err != nil {
+				return nil, err
+			}
+			if !p.accept(';') {
+				return nil, p.errorf("expected ';' after value of key %q", key)
+			}
+		}
+		if comment == appleNoComment {
+			comment = ""
+		}
+		c.Entries = append(c.Entries, Entry{Key: key, Description: comment, Text: value})
+	}
+	return c, nil
+}
+
+// appleScanner .strings 文件的词法分析器
+type appleScanner struct {
+	src  []rune
+	pos  int
+	line int
+}
+
+func (p *appleScanner) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *appleScanner) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: line %d: %s", ErrSyntax, p.line, fmt.Sprintf(format, args...))
+}
+
+// next 读取一个字符并记录行号
+func (p *appleScanner) next() rune {
+	r := p.src[p.pos]
+	p.pos++
+	if r == '\n' {
+		p.line++
+	}
+	return r
+}
+
+// accept 下一个字符为 r 时读取
+func (p *appleScanner) accept(r rune) bool {
+	if !p.eof() && p.src[p.pos] == r {
+		p.next()
+		return true
+	}
+	return false
+}
+
+// skip 跳过空白和注释，返回最后一个注释的内容
+func (p *appleScanner) skip() (string, error) {
+	comment := ""
+	for !p.eof() {
+		r := p.src[p.pos]
+		switch {
+		case unicode.IsSpace(r):
+			p.next()
+		case r == '/' && p.pos+1 < len(p.src) && p.src[p.pos+1] == '*':
+			start := p.pos + 2
+			p.pos += 2
+			for !p.eof() && !(p.src[p.pos] == '*' && p.pos+1 < len(p.src) && p.src[p.pos+1] == '/') {
+				p.next()
+			}
+			if p.eof() {
+				return "", p.errorf("unterminated comment")
+			}
+			comment = strings.TrimSpace(string(p.src[start:p.pos]))
+			p.pos += 2
+		case r == '/' && p.pos+1 < len(p.src) && p.src[p.pos+1] == '/':
+			start := p.pos + 2
+			for !p.eof() && p.src[p.pos] != '\n' {
+				p.next()
+			}
+			comment = strings.TrimSpace(string(p.src[start:p.pos]))
+		default:
+			return comment, nil
+		}
+	}
+	return comment, nil
+}
+
+// string 读取带引号的字符串或不带引号的标识符
+func (p *appleScanner) string() (string, error) {
+	if p.eof() {
+		return "", p.errorf("unexpected end of file")
+	}
+	if !p.accept('"') {
+		start := p.pos
+		for !p.eof() && isAppleUnquoted(p.src[p.pos]) {
+			p.next()
+		}
+		if start == p.pos {
+			return "", p.errorf("unexpected character %q", p.src[p.pos])
+		}
+		return string(p.src[start:p.pos]), nil
+	}
+	var sb strings.Builder
+	for {
+		if p.eof() {
+			return "", p.errorf("unterminated string")
+		}
+		r := p.next()
+		if r == '"' {
+			return sb.String(), nil
+		}
+		if r != '\\' {
+			sb.WriteRune(r)
+			continue
+		}
+		if p.eof() {
+			return "", p.errorf("unterminated string")
+		}
+		switch r = p.next(); r {
+		case 'n':
+			sb.WriteRune('\n')
+		case 't':
+			sb.WriteRune('\t')
+		case 'r':
+			sb.WriteRune('\r')
+		case 'U', 'u':
+			if p.pos+4 > len(p.src) {
+				return "", p.errorf("invalid unicode escape")
+			}
+			n, err := strconv.ParseUint(string(p.src[p.pos:p.pos+4]), 16, 32)
+			if err != nil {
+				return "", p.errorf("invalid unicode escape \\%c%s", r, string(p.src[p.pos:p.pos+4]))
+			}
+			sb.WriteRune(rune(n))
+			p.pos += 4
+		default:
+			sb.WriteRune(r)
+		}
+	}
+}
+
+// isAppleUnquoted 是否为不带引号的字符串中允许的字符
+func isAppleUnquoted(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_$+/:.-", r)
+}
+
+// plistDict plist 中的字典，值为字符串或嵌套的字典，其他类型的值为 nil
+type plistDict struct {
+	keys     []string
+	values   map[string]interface{}
+	comments map[string]string // 键前的注释
+}
+
+// ParseAppleStringsdict 解析 iOS .stringsdict 文件。格式字符串只能引用一个复数变量，
+// 格式字符串中变量以外的文本拼接到每个复数形式中；紧邻资源前的注释作为描述。
+// 文件中没有语言信息，语言由调用方指定。
+func ParseAppleStringsdict(r io.Reader) (*Catalog, error) {
+	d := xml.NewDecoder(appleReader(r))
+	var root *plistDict
+	for root == nil {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%w: plist has no dict", ErrSyntax)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+		}
+		if t, ok := tok.(xml.StartElement); ok && t.Name.Local == "dict" {
+			if root, err = parsePlistDict(d); err != nil {
+				return nil, err
+			}
+		}
+	}
+	c := &Catalog{}
+	for _, key := range root.keys {
+		entry, ok := root.values[key].(*plistDict)
+		if !ok {
+			return nil, fmt.Errorf("%w: key %s: expected dict", ErrSyntax, key)
+		}
+		format, _ := entry.values[appleFormatKey].(string)
+		vars := appleVariable.FindAllStringSubmatchIndex(format, -1)
+		if len(vars) != 1 {
+			return nil, fmt.Errorf("%w: key %s: format %q must reference exactly one plural variable", ErrSyntax, key, format)
+		}
+		name := format[vars[0][2]:vars[0][3]]
+		rule, ok := entry.values[name].(*plistDict)
+		if !ok {
+			return nil, fmt.Errorf("%w: key %s: variable %s not defined", ErrSyntax, key, name)
+		}
+		e := Entry{Key: key, Description: root.comments[key], Plural: true, Plurals: make(map[string]string)}
+		for _, category := range rule.keys {
+			text, ok := rule.values[category].(string)
+			if !ok || !msgformat.IsPluralCategory(category) {
+				continue
+			}
+			e.Plurals[category] = format[:vars[0][0]] + text + format[vars[0][1]:]
+		}
+		e.Text = pluralText(e.Plurals, "other", "")
+		c.Entries = append(c.Entries, e)
+	}
+	return c, nil
+}
+
+// parsePlistDict 解析 <dict> 开始标签之后的内容
+func parsePlistDict(d *xml.Decoder) (*plistDict, error) {
+	dict := &plistDict{values: make(map[string]interface{}), comments: make(map[string]string)}
+	key, comment := "", ""
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+		}
+		switch t := tok.(type) {
+		case xml.Comment:
+			comment = strings.TrimSpace(string(t))
+		case xml.EndElement:
+			return dict, nil
+		case xml.StartElement:
+			if t.Name.Local == "key" {
+				if err := d.DecodeElement(&key, &t); err != nil {
+					return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+				}
+				dict.keys = append(dict.keys, key)
+				if comment != "" {
+					dict.comments[key], comment = comment, ""
+				}
+				continue
+			}
+			var value interface{}
+			switch t.Name.Local {
+			case "dict":
+				if value, err = parsePlistDict(d); err != nil {
+					return nil, err
+				}
+			case "string":
+				var s string
+				if err := d.DecodeElement(&s, &t); err != nil {
+					return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+				}
+				value = s
+			default:
+				if err := d.Skip(); err != nil {
+					return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+				}
+			}
+			dict.values[key] = value
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Package formats 实现资源在常见本地化文件格式与语言目录之间的转换。
+//
+// 语言目录 Catalog 是与格式无关的中间表示：导出时由资源键和翻译构建目录后按格式写出，
+// 导入时按格式解析为目录后写入资源键和翻译。
+package formats
+
+import "errors"
+
+// ErrSyntax 文件格式错误
+var ErrSyntax = errors.New("syntax error")
+
+// Catalog 一个语言的资源目录
+type Catalog struct {
+	Culture       string  // 语言代码，模板为空
+	SourceCulture string  // 原文的语言代码，即默认语言
+	PluralLocale  string  // 复数规则使用的语言，为空时使用语言代码
+	Entries       []Entry // 资源项
+}
+
+// Entry 一个资源键及其翻译
+type Entry struct {
+	Key           string            // 资源键名称
+	Type          string            // 资源类型名称
+	Description   string            // 描述
+	Context       string            // 给翻译人员的上下文说明
+	MaxLength     int32             // 翻译的最大字符数，0 表示不限制
+	Text          string            // 翻译，未翻译或模板为空
+	Plurals       map[string]string // 复数形式，CLDR复数类别 -> 文本
+	Plural        bool              // 是否为复数资源，未翻译时根据默认语言的翻译判断
+	Fuzzy         bool              // 翻译未审核通过
+	Source        string            // 原文，即默认语言的翻译
+	SourcePlurals map[string]string // 原文的复数形式
+	Hash          string            // 翻译所依据的原文的哈希，只在导入 go-i18n 消息文件时使用
+}
+
+// pluralLocale 复数规则使用的语言
+func (c *Catalog) pluralLocale() string {
+	if c.PluralLocale != "" {
+		return c.PluralLocale
+	}
+	return c.Culture
+}
@@ -0,0 +1,260 @@
+// formats/goi18n.go
+package formats
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"i18n-service/msgformat"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// GoI18nEncoding go-i18n v2 消息文件的编码
+type GoI18nEncoding int
+
+const (
+	GoI18nTOML GoI18nEncoding = iota // active.<语言>.toml
+	GoI18nJSON                       // active.<语言>.json
+)
+
+// go-i18n 消息的保留键，值为字符串的保留键表示所在的表是一个消息
+const (
+	goI18nID          = "id"
+	goI18nDescription = "description"
+	goI18nHash        = "hash"
+	goI18nTranslation = "translation" // v1 格式的翻译，值为字符串或复数形式
+)
+
+// goI18nReserved go-i18n 消息中除复数类别外的保留键
+var goI18nReserved = map[string]bool{
+	goI18nID: true, goI18nDescription: true, goI18nHash: true,
+	"leftdelim": true, "rightdelim": true, goI18nTranslation: true,
+}
+
+// goI18nOther 非复数消息的文本所在的复数类别
+const goI18nOther = "other"
+
+// goI18nCategories go-i18n 消息中的复数类别，顺序与 CLDR 一致
+var goI18nCategories = []string{"zero", "one", "two", "few", "many", goI18nOther}
+
+// goI18nNestedSeparator 嵌套的表中消息 ID 的分隔符
+const goI18nNestedSeparator = "."
+
+// GoI18nHash 计算 go-i18n 的消息哈希，与 goi18n merge 一致，由描述和原文的 other 形式计算，
+// 用于判断翻译所依据的原文是否已变化
+func GoI18nHash(description, source string) string {
+	h := sha1.New()
+	_, _ = io.WriteString(h, description)
+	_, _ = io.WriteString(h, source)
+	return fmt.Sprintf("sha1-%x", h.Sum(nil))
+}
+
+// WriteGoI18n 将语言目录写出为 go-i18n v2 消息文件，与 goi18n merge 生成的 active 文件格式一致。
+// 只写出已翻译且审核通过的资源项，待定的翻译不写出，运行时回退到默认语言。消息 ID 为资源键名称，
+// 默认语言中没有描述的非复数资源写为字符串，其他资源写为包含描述和复数形式的表，非默认语言还包含原文的哈希。
+// 资源类型、上下文说明和最大字符数不写出。
+func WriteGoI18n(w io.Writer, c *Catalog, encoding GoI18nEncoding) error {
+	source := c.Culture == c.SourceCulture
+	messages := make(map[string]interface{}, len(c.Entries))
+	for _, e := range c.Entries {
+		if e.Fuzzy || (e.Text == "" && len(e.Plurals) == 0) {
+			continue
+		}
+		if source && !e.Plural && e.Description == "" {
+			messages[e.Key] = e.Text
+			continue
+		}
+		m := map[string]string{}
+		if e.Description != "" {
+			m[goI18nDescription] = e.Description
+		}
+		if !source {
+			// 复数资源的原文为 other 形式，默认语言的翻译文本即为其 other 形式
+			m[goI18nHash] = GoI18nHash(e.Description, e.Source)
+		}
+		for category, text := range e.Plurals {
+			m[category] = text
+		}
+		if _, ok := m[goI18nOther]; !ok {
+			m[goI18nOther] = e.Text
+		}
+		messages[e.Key] = m
+	}
+	switch encoding {
+	case GoI18nTOML:
+		enc := toml.NewEncoder(w)
+		enc.SetIndentTables(false)
+		return enc.Encode(messages)
+	case GoI18nJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(messages)
+	}
+	return fmt.Errorf("unsupported go-i18n encoding %d", encoding)
+}
+
+// ParseGoI18n 解析 go-i18n 消息文件，支持嵌套的表（消息 ID 以 . 连接）及 v1 格式的 translation。
+// 文件中没有语言信息，语言由调用方指定。包含 other 以外复数类别的消息视为复数资源，文本为 other 形式，
+// 消息的哈希写入 Hash，由调用方与当前的原文比较。
+func ParseGoI18n(r io.Reader, encoding GoI18nEncoding) (*Catalog, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var raw interface{}
+	switch encoding {
+	case GoI18nTOML:
+		err = toml.Unmarshal(data, &raw)
+	case GoI18nJSON:
+		d := json.NewDecoder(bytes.NewReader(data))
+		d.UseNumber()
+		err = d.Decode(&raw)
+	default:
+		return nil, fmt.Errorf("unsupported go-i18n encoding %d", encoding)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+	}
+	c := &Catalog{}
+	switch v := raw.(type) {
+	case map[string]interface{}:
+		if err := c.goI18nMessages("", v); err != nil {
+			return nil, err
+		}
+	case []interface{}:
+		// v1 格式为消息数组，每个消息包含 id
+		for _, item := range v {
+			if err := c.goI18nMessage("", item); err != nil {
+				return nil, err
+			}
+		}
+	case nil:
+	default:
+		return nil, fmt.Errorf("%w: expected key-values, got %T", ErrSyntax, raw)
+	}
+	sort.Slice(c.Entries, func(i, j int) bool { return c.Entries[i].Key < c.Entries[j].Key })
+	return c, nil
+}
+
+// goI18nMessages 解析不是消息的表，其中每一项为消息或嵌套的表
+func (c *Catalog) goI18nMessages(prefix string, table map[string]interface{}) error {
+	for id, v := range table {
+		if prefix != "" {
+			id = prefix + goI18nNestedSeparator + id
+		}
+		isMessage, err := isGoI18nMessage(id, v)
+		if err != nil {
+			return err
+		}
+		if isMessage {
+			if err := c.goI18nMessage(id, v); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.goI18nMessages(id, v.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isGoI18nMessage 判断值是否为一个消息：字符串为只有 other 形式的消息，包含保留键的表为消息，
+// 表中同时包含保留键和其他键时返回错误
+func isGoI18nMessage(id string, v interface{}) (bool, error) {
+	table, ok := v.(map[string]interface{})
+	if !ok {
+		if _, ok := v.(string); ok || v == nil {
+			return true, nil
+		}
+		return false, fmt.Errorf("%w: message %s: unsupported value %v", ErrSyntax, id, v)
+	}
+	var reserved, other []string
+	for k, v := range table {
+		lk := strings.ToLower(k)
+		_, isString := v.(string)
+		if k == goI18nTranslation || (isString && (goI18nReserved[lk] || msgformat.IsPluralCategory(lk))) {
+			reserved = append(reserved, k)
+		} else {
+			other = append(other, k)
+		}
+	}
+	if len(reserved) > 0 && len(other) > 0 {
+		sort.Strings(reserved)
+		sort.Strings(other)
+		return false, fmt.Errorf("%w: message %s: reserved keys %v mixed with unreserved keys %v", ErrSyntax, id, reserved, other)
+	}
+	return len(reserved) > 0, nil
+}
+
+// goI18nMessage 将消息转换为资源项，消息中的 id 优先于表名
+func (c *Catalog) goI18nMessage(id string, v interface{}) error {
+	fields := make(map[string]string)
+	switch v := v.(type) {
+	case nil:
+	case string:
+		fields[goI18nOther] = v
+	case map[string]interface{}:
+		for k, value := range v {
+			if err := goI18nField(fields, id, k, value); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("%w: message %s: unsupported value %v", ErrSyntax, id, v)
+	}
+	if fields[goI18nID] != "" {
+		id = fields[goI18nID]
+	}
+	if id == "" {
+		return fmt.Errorf("%w: message without id", ErrSyntax)
+	}
+	e := Entry{Key: id, Description: fields[goI18nDescription], Hash: fields[goI18nHash], Text: fields[goI18nOther]}
+	for _, category := range goI18nCategories {
+		if category != goI18nOther && fields[category] != "" {
+			e.Plural = true
+		}
+	}
+	if e.Plural {
+		e.Plurals = make(map[string]string)
+		for _, category := range goI18nCategories {
+			if text, ok := fields[category]; ok && text != "" {
+				e.Plurals[category] = text
+			}
+		}
+	}
+	c.Entries = append(c.Entries, e)
+	return nil
+}
+
+// goI18nField 读取消息的一个字段，键不区分大小写，v1 格式的 translation 为字符串或复数形式的表
+func goI18nField(fields map[string]string, id, k string, v interface{}) error {
+	if k == goI18nTranslation {
+		switch t := v.(type) {
+		case string:
+			fields[goI18nOther] = t
+			return nil
+		case map[string]interface{}:
+			for kk, vv := range t {
+				if err := goI18nField(fields, id, kk, vv); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	switch t := v.(type) {
+	case string:
+		fields[strings.ToLower(k)] = t
+	case nil:
+	default:
+		return fmt.Errorf("%w: message %s: expected value for key %q to be a string, got %v", ErrSyntax, id, k, v)
+	}
+	return nil
+}
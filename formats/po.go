@@ -0,0 +1,397 @@
+// formats/po.go
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"i18n-service/msgformat"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// PO 文件中资源键的元数据写在提取注释中
+const (
+	poContextPrefix   = "Context: "
+	poMaxLengthPrefix = "Max length: "
+)
+
+// gettextPlural gettext 的复数规则，categories 为 msgstr[n] 对应的 CLDR 复数类别
+type gettextPlural struct {
+	nplurals   int
+	expression string
+	categories []string
+}
+
+var (
+	pluralOther    = gettextPlural{1, "0", []string{"other"}}
+	pluralNotOne   = gettextPlural{2, "(n != 1)", []string{"one", "other"}}
+	pluralAboveOne = gettextPlural{2, "(n > 1)", []string{"one", "other"}}
+	pluralSlavic   = gettextPlural{3, "(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2)", []string{"one", "few", "many"}}
+	pluralBalkan   = gettextPlural{3, "(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2)", []string{"one", "few", "other"}}
+	pluralPolish   = gettextPlural{3, "(n==1 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2)", []string{"one", "few", "many"}}
+	pluralCzech    = gettextPlural{3, "(n==1) ? 0 : (n>=2 && n<=4) ? 1 : 2", []string{"one", "few", "other"}}
+	pluralArabic   = gettextPlural{6, "(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n%100>=3 && n%100<=10 ? 3 : n%100>=11 ? 4 : 5)", []string{"zero", "one", "two", "few", "many", "other"}}
+)
+
+// gettextPlurals 常用语言的 gettext 复数规则，按基础语言匹配
+var gettextPlurals = map[string]gettextPlural{
+	"ja": pluralOther, "zh": pluralOther, "ko": pluralOther, "vi": pluralOther, "th": pluralOther,
+	"id": pluralOther, "ms": pluralOther, "lo": pluralOther, "km": pluralOther, "my": pluralOther,
+	"en": pluralNotOne, "de": pluralNotOne, "nl": pluralNotOne, "sv": pluralNotOne, "da": pluralNotOne,
+	"nb": pluralNotOne, "nn": pluralNotOne, "no": pluralNotOne, "fi": pluralNotOne, "et": pluralNotOne,
+	"it": pluralNotOne, "es": pluralNotOne, "el": pluralNotOne, "hu": pluralNotOne, "bg": pluralNotOne,
+	"ca": pluralNotOne, "tr": pluralNotOne, "az": pluralNotOne, "ka": pluralNotOne, "kk": pluralNotOne,
+	"fr": pluralAboveOne, "pt": pluralAboveOne, "hi": pluralAboveOne, "fa": pluralAboveOne,
+	"ru": pluralSlavic, "uk": pluralSlavic, "be": pluralSlavic,
+	"sr": pluralBalkan, "hr": pluralBalkan, "bs": pluralBalkan,
+	"pl": pluralPolish,
+	"cs": pluralCzech, "sk": pluralCzech,
+	"ar": pluralArabic,
+}
+
+// pluralRule 获取语言的 gettext 复数规则，未收录的语言根据 CLDR 复数类别选择只有一种形式或区分单复数的规则
+func pluralRule(locale string) gettextPlural {
+	if tag, err := language.Parse(locale); err == nil {
+		base, _ := tag.Base()
+		if rule, ok := gettextPlurals[base.String()]; ok {
+			return rule
+		}
+	}
+	if len(msgformat.PluralCategories(locale)) == 1 {
+		return pluralOther
+	}
+	return pluralNotOne
+}
+
+// WritePO 将语言目录写出为 gettext PO 文件，template 为 true 时写出不含翻译的 POT 模板。
+// msgid 为资源键名称，msgctxt 为资源类型名称，资源键的描述、上下文说明和最大字符数写在提取注释中，
+// 未审核通过的翻译标记为 fuzzy。
+func WritePO(w io.Writer, c *Catalog, template bool) error {
+	b := bufio.NewWriter(w)
+	rule := pluralRule(c.pluralLocale())
+	header := []string{
+		"Language: " + c.Culture,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"Content-Transfer-Encoding: 8bit",
+		fmt.Sprintf("Plural-Forms: nplurals=%d; plural=%s;", rule.nplurals, rule.expression),
+	}
+	if template {
+		header[0] = "Language: "
+		header[4] = "Plural-Forms: nplurals=INTEGER; plural=EXPRESSION;"
+	}
+	b.WriteString("msgid \"\"\nmsgstr \"\"\n")
+	for _, v := range header {
+		b.WriteString(poQuote(v+"\n") + "\n")
+	}
+	for _, e := range c.Entries {
+		b.WriteByte('\n')
+		for _, line := range splitLines(e.Description) {
+			b.WriteString("#. " + line + "\n")
+		}
+		for _, line := range splitLines(e.Context) {
+			b.WriteString("#. " + poContextPrefix + line + "\n")
+		}
+		if e.MaxLength > 0 {
+			b.WriteString("#. " + poMaxLengthPrefix + strconv.Itoa(int(e.MaxLength)) + "\n")
+		}
+		if e.Fuzzy && !template {
+			b.WriteString("#, fuzzy\n")
+		}
+		if e.Type != "" {
+			writePOString(b, "msgctxt", e.Type)
+		}
+		writePOString(b, "msgid", e.Key)
+		if !e.Plural {
+			text := e.Text
+			if template {
+				text = ""
+			}
+			writePOString(b, "msgstr", text)
+			continue
+		}
+		writePOString(b, "msgid_plural", e.Key)
+		if template {
+			writePOString(b, "msgstr[0]", "")
+			writePOString(b, "msgstr[1]", "")
+			continue
+		}
+		for i, category := range rule.categories {
+			text, ok := e.Plurals[category]
+			if !ok {
+				text = e.Plurals["other"]
+			}
+			if text == "" {
+				text = e.Text
+			}
+			writePOString(b, fmt.Sprintf("msgstr[%d]", i), text)
+		}
+	}
+	return b.Flush()
+}
+
+// splitLines 按行拆分文本，空文本返回 nil
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
+
+// writePOString 写出关键字及其字符串，多行文本在每个换行符后折行
+func writePOString(b *bufio.Writer, keyword, s string) {
+	if !strings.Contains(strings.TrimSuffix(s, "\n"), "\n") {
+		b.WriteString(keyword + " " + poQuote(s) + "\n")
+		return
+	}
+	b.WriteString(keyword + " \"\"\n")
+	for _, line := range strings.SplitAfter(s, "\n") {
+		if line != "" {
+			b.WriteString(poQuote(line) + "\n")
+		}
+	}
+}
+
+// poQuote 按 C 字符串规则转义并加引号
+func poQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`).Replace(s) + `"`
+}
+
+// poUnquote 去掉引号并还原转义字符
+func poUnquote(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", fmt.Errorf("string must be quoted: %s", s)
+	}
+	s = s[1 : len(s)-1]
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ch != '\\' || i == len(s)-1 {
+			b.WriteByte(ch)
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case 'a':
+			b.WriteByte('\a')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'v':
+			b.WriteByte('\v')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String(), nil
+}
+
+// poMessage 解析中的 PO 条目
+type poMessage struct {
+	comments []string        // 提取注释
+	flags    []string        // 标记
+	ctxt     *string         // msgctxt
+	id       string          // msgid
+	idPlural string          // msgid_plural
+	str      string          // msgstr
+	strs     map[int]*string // msgstr[n]
+	started  bool            // 是否已读取 msgid
+	done     bool            // 是否已读取 msgstr，之后的注释属于下一个条目
+}
+
+// ParsePO 解析 gettext PO 或 POT 文件。pluralLocale 为 msgstr[n] 使用的复数规则的语言，
+// 为空时使用文件头的 Language。复数形式中缺少的 CLDR 类别使用最后一种形式的文本，
+// 未翻译的条目只包含资源键，已废弃的条目被忽略。
+func ParsePO(r io.Reader, pluralLocale string) (*Catalog, error) {
+	c := &Catalog{}
+	var messages []*poMessage
+	m := &poMessage{}
+	var target *string // 续行追加到的字符串
+	flush := func() {
+		if m.started {
+			messages = append(messages, m)
+		}
+		m, target = &poMessage{}, nil
+	}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if n == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		switch {
+		case line == "":
+			target = nil
+		case strings.HasPrefix(line, "#"):
+			if strings.HasPrefix(line, "#~") {
+				// 已废弃的条目
+				continue
+			}
+			if m.done {
+				flush()
+			}
+			switch {
+			case strings.HasPrefix(line, "#."):
+				m.comments = append(m.comments, strings.TrimPrefix(strings.TrimPrefix(line, "#."), " "))
+			case strings.HasPrefix(line, "#,"):
+				for _, flag := range strings.Split(strings.TrimPrefix(line, "#,"), ",") {
+					m.flags = append(m.flags, strings.TrimSpace(flag))
+				}
+			}
+			target = nil
+		case strings.HasPrefix(line, `"`):
+			if target == nil {
+				return nil, fmt.Errorf("%w: line %d: unexpected string", ErrSyntax, n)
+			}
+			s, err := poUnquote(line)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrSyntax, n, err)
+			}
+			*target += s
+		default:
+			keyword, value, _ := strings.Cut(line, " ")
+			s, err := poUnquote(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrSyntax, n, err)
+			}
+			if (keyword == "msgctxt" || keyword == "msgid") && m.started {
+				flush()
+			}
+			switch {
+			case keyword == "msgctxt":
+				m.ctxt = &s
+				target = m.ctxt
+			case keyword == "msgid":
+				m.id, m.started = s, true
+				target = &m.id
+			case keyword == "msgid_plural" && m.started:
+				m.idPlural = s
+				target = &m.idPlural
+			case keyword == "msgstr" && m.started:
+				m.str, m.done = s, true
+				target = &m.str
+			case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]") && m.started:
+				i, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+				if err != nil || i < 0 {
+					return nil, fmt.Errorf("%w: line %d: invalid plural index %s", ErrSyntax, n, keyword)
+				}
+				if m.strs == nil {
+					m.strs = make(map[int]*string)
+				}
+				m.strs[i], m.done = &s, true
+				target = m.strs[i]
+			default:
+				return nil, fmt.Errorf("%w: line %d: unexpected keyword %s", ErrSyntax, n, keyword)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return c, c.fromPO(messages, pluralLocale)
+}
+
+// fromPO 将解析的条目转换为资源项，文件头条目设置语言代码
+func (c *Catalog) fromPO(messages []*poMessage, pluralLocale string) error {
+	for _, m := range messages {
+		if m.id == "" && m.ctxt == nil {
+			c.parsePOHeader(m.str)
+			continue
+		}
+		e := Entry{Key: m.id}
+		if m.ctxt != nil {
+			e.Type = *m.ctxt
+		}
+		var description []string
+		var context []string
+		for _, v := range m.comments {
+			switch {
+			case strings.HasPrefix(v, poContextPrefix):
+				context = append(context, strings.TrimPrefix(v, poContextPrefix))
+			case strings.HasPrefix(v, poMaxLengthPrefix):
+				n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(v, poMaxLengthPrefix)))
+				if err != nil || n < 0 {
+					return fmt.Errorf("%w: key %s: invalid max length %q", ErrSyntax, m.id, v)
+				}
+				e.MaxLength = int32(n)
+			default:
+				description = append(description, v)
+			}
+		}
+		e.Description = strings.Join(description, "\n")
+		e.Context = strings.Join(context, "\n")
+		for _, flag := range m.flags {
+			if flag == "fuzzy" {
+				e.Fuzzy = true
+			}
+		}
+		if m.idPlural == "" {
+			e.Text = m.str
+		} else if err := c.poPlurals(&e, m.strs, pluralLocale); err != nil {
+			return err
+		}
+		c.Entries = append(c.Entries, e)
+	}
+	return nil
+}
+
+// poPlurals 将 msgstr[n] 按语言的 gettext 复数规则转换为 CLDR 复数形式，全部为空时视为未翻译
+func (c *Catalog) poPlurals(e *Entry, strs map[int]*string, pluralLocale string) error {
+	e.Plural = true
+	if pluralLocale == "" {
+		pluralLocale = c.Culture
+	}
+	rule := pluralRule(pluralLocale)
+	indexes := make([]int, 0, len(strs))
+	empty := true
+	for i, s := range strs {
+		if i >= len(rule.categories) {
+			return fmt.Errorf("%w: key %s: plural index %d exceeds nplurals=%d of %s", ErrSyntax, e.Key, i, rule.nplurals, pluralLocale)
+		}
+		indexes = append(indexes, i)
+		empty = empty && *s == ""
+	}
+	if empty {
+		return nil
+	}
+	sort.Ints(indexes)
+	e.Plurals = make(map[string]string)
+	var last string
+	for _, i := range indexes {
+		last = *strs[i]
+		e.Plurals[rule.categories[i]] = last
+	}
+	for _, category := range msgformat.PluralCategories(pluralLocale) {
+		if _, ok := e.Plurals[category]; !ok {
+			e.Plurals[category] = last
+		}
+	}
+	e.Text = e.Plurals["other"]
+	return nil
+}
+
+// parsePOHeader 读取文件头中的语言代码
+func (c *Catalog) parsePOHeader(header string) {
+	for _, line := range strings.Split(header, "\n") {
+		name, value, ok := strings.Cut(line, ":")
+		if ok && strings.EqualFold(strings.TrimSpace(name), "Language") {
+			c.Culture = strings.ReplaceAll(strings.TrimSpace(value), "_", "-")
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// formats/resx.go
+package formats
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"i18n-service/msgformat"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// RESX 文件头，ResXResourceReader 要求包含 resmimetype、reader 和 writer
+var resxHeaders = [][2]string{
+	{"resmimetype", "text/microsoft-resx"},
+	{"version", "2.0"},
+	{"reader", "System.Resources.ResXResourceReader, System.Windows.Forms, Version=4.0.0.0, Culture=neutral, PublicKeyToken=b77a5c561934e089"},
+	{"writer", "System.Resources.ResXResourceWriter, System.Windows.Forms, Version=4.0.0.0, Culture=neutral, PublicKeyToken=b77a5c561934e089"},
+}
+
+// resxEscape 转义 RESX 的文本，值使用 xml:space="preserve"，换行原样保留，回车使用字符引用以免被 XML 解析器规范化
+var resxEscape = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;").Replace
+
+// WriteRESX 将语言目录写出为 .NET RESX 资源文件，只写出已翻译且审核通过的资源项，待定的翻译运行时回退到非特定语言资源。
+// 资源名称为资源键名称，描述写在 comment 中；RESX 没有复数形式，复数资源按语言的复数类别写为多个资源，
+// 名称为资源键名称加复数类别，例如 files[one]。
+func WriteRESX(w io.Writer, c *Catalog) error {
+	b := bufio.NewWriter(w)
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n<root>\n")
+	for _, h := range resxHeaders {
+		b.WriteString(`  <resheader name="` + h[0] + "\">\n    <value>" + xmlEscape(h[1]) + "</value>\n  </resheader>\n")
+	}
+	for _, e := range c.Entries {
+		if e.Fuzzy || (e.Text == "" && len(e.Plurals) == 0) {
+			continue
+		}
+		if !e.Plural {
+			if err := writeRESXData(b, e.Key, e.Text, e.Description); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, category := range msgformat.PluralCategories(c.pluralLocale()) {
+			if err := writeRESXData(b, e.Key+"["+category+"]", pluralText(e.Plurals, category, e.Text), e.Description); err != nil {
+				return err
+			}
+		}
+	}
+	b.WriteString("</root>\n")
+	return b.Flush()
+}
+
+// writeRESXData 写出一个字符串资源，XML 1.0 不允许的控制字符无法写出
+func writeRESXData(b *bufio.Writer, name, value, comment string) error {
+	for _, s := range []string{name, value, comment} {
+		if i := strings.IndexFunc(s, func(r rune) bool { return !isXMLChar(r) }); i >= 0 {
+			r, _ := utf8.DecodeRuneInString(s[i:])
+			return fmt.Errorf("resource %s: character %U is not allowed in XML", name, r)
+		}
+	}
+	b.WriteString(`  <data name="` + xmlEscape(name) + "\" xml:space=\"preserve\">\n")
+	b.WriteString("    <value>" + resxEscape(value) + "</value>\n")
+	if comment != "" {
+		b.WriteString("    <comment>" + resxEscape(comment) + "</comment>\n")
+	}
+	b.WriteString("  </data>\n")
+	return nil
+}
+
+// isXMLChar 是否为 XML 1.0 允许的字符
+func isXMLChar(r rune) bool {
+	return r == '\t' || r == '\n' || r == '\r' ||
+		(r >= 0x20 && r <= 0xD7FF) || (r >= 0xE000 && r <= 0xFFFD) || (r >= 0x10000 && r <= 0x10FFFF)
+}
+
+type resxDocument struct {
+	XMLName xml.Name   `xml:"root"`
+	Data    []resxData `xml:"data"`
+}
+
+type resxData struct {
+	Name     string    `xml:"name,attr"`
+	Type     string    `xml:"type,attr"`
+	MimeType string    `xml:"mimetype,attr"`
+	Value    xliffText `xml:"value"`
+	Comment  string    `xml:"comment"`
+}
+
+// ParseRESX 解析 .NET RESX 资源文件及其附属语言文件（例如 Resources.de.resx）。
+// 字符串资源转换为资源项，comment 作为描述，文件、图片等指定了 mimetype 或非字符串 type 的资源忽略；
+// 名称为资源键名称加复数类别的资源合并为复数资源。文件中没有语言信息，语言由调用方指定。
+func ParseRESX(r io.Reader) (*Catalog, error) {
+	var doc resxDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+	}
+	c := &Catalog{}
+	index := make(map[string]int)
+	for _, d := range doc.Data {
+		if d.MimeType != "" || (d.Type != "" && !strings.HasPrefix(d.Type, "System.String")) {
+			continue
+		}
+		if d.Name == "" {
+			return nil, fmt.Errorf("%w: data without name", ErrSyntax)
+		}
+		if len(d.Value.Inline) > 0 {
+			return nil, fmt.Errorf("%w: data %s: unexpected element <%s> in value", ErrSyntax, d.Name, d.Value.Inline[0].XMLName.Local)
+		}
+		m := xliffPluralID.FindStringSubmatch(d.Name)
+		if m == nil {
+			c.Entries = append(c.Entries, Entry{Key: d.Name, Description: d.Comment, Text: d.Value.Text})
+			continue
+		}
+		key, category := m[1], m[2]
+		i, ok := index[key]
+		if !ok {
+			i = len(c.Entries)
+			index[key] = i
+			c.Entries = append(c.Entries, Entry{Key: key, Description: d.Comment, Plural: true, Plurals: make(map[string]string)})
+		}
+		c.Entries[i].Plurals[category] = d.Value.Text
+	}
+	for i := range c.Entries {
+		if e := &c.Entries[i]; e.Plural {
+			e.Text = pluralText(e.Plurals, "other", "")
+		}
+	}
+	return c, nil
+}
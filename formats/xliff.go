@@ -0,0 +1,421 @@
+// formats/xliff.go
+package formats
+
+import (
+	"encoding/xml"
+	"fmt"
+	"i18n-service/msgformat"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// XLIFF 命名空间
+const (
+	xliff12Namespace = "urn:oasis:names:tc:xliff:document:1.2"
+	xliff20Namespace = "urn:oasis:names:tc:xliff:document:2.0"
+)
+
+// XLIFF 注释中资源键元数据的类别
+const (
+	xliffNoteDescription = "description"
+	xliffNoteContext     = "context"
+	xliffNoteMaxLength   = "max-length"
+)
+
+// xliffPluralID 复数资源的每个复数形式写为一个单元，ID 为资源键名称加复数类别，例如 files[one]
+var xliffPluralID = regexp.MustCompile(`^(.+)\[(zero|one|two|few|many|other)\]$`)
+
+// xliffUnit 与版本无关的翻译单元
+type xliffUnit struct {
+	id          string
+	source      string
+	target      string
+	translated  bool // 目标文本是否为有效的翻译，未翻译的状态为 false
+	final       bool // 翻译已审核通过
+	description string
+	context     string
+	maxLength   int32
+}
+
+// xliffUnits 将资源项展开为翻译单元，复数资源按目标语言的复数类别展开
+func (c *Catalog) xliffUnits() []xliffUnit {
+	var units []xliffUnit
+	for _, e := range c.Entries {
+		unit := xliffUnit{
+			id:          e.Key,
+			source:      e.Source,
+			target:      e.Text,
+			translated:  e.Text != "" || len(e.Plurals) > 0,
+			final:       !e.Fuzzy,
+			description: e.Description,
+			context:     e.Context,
+			maxLength:   e.MaxLength,
+		}
+		if !e.Plural {
+			units = append(units, unit)
+			continue
+		}
+		for _, category := range msgformat.PluralCategories(c.pluralLocale()) {
+			form := unit
+			form.id = e.Key + "[" + category + "]"
+			form.source = pluralText(e.SourcePlurals, category, e.Source)
+			form.target = pluralText(e.Plurals, category, e.Text)
+			units = append(units, form)
+		}
+	}
+	return units
+}
+
+// pluralText 获取复数类别的文本，缺少时依次使用 other 和 text
+func pluralText(plurals map[string]string, category, text string) string {
+	if v, ok := plurals[category]; ok {
+		return v
+	}
+	if v, ok := plurals["other"]; ok {
+		return v
+	}
+	return text
+}
+
+type xliff12Document struct {
+	XMLName xml.Name      `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string        `xml:"version,attr"`
+	Files   []xliff12File `xml:"file"`
+}
+
+type xliff12File struct {
+	Original       string         `xml:"original,attr"`
+	SourceLanguage string         `xml:"source-language,attr"`
+	TargetLanguage string         `xml:"target-language,attr,omitempty"`
+	Datatype       string         `xml:"datatype,attr"`
+	Units          []xliff12Unit  `xml:"body>trans-unit"`
+	Groups         []xliff12Group `xml:"body>group"`
+}
+
+type xliff12Group struct {
+	Units  []xliff12Unit  `xml:"trans-unit"`
+	Groups []xliff12Group `xml:"group"`
+}
+
+type xliff12Unit struct {
+	ID       string         `xml:"id,attr"`
+	Resname  string         `xml:"resname,attr,omitempty"`
+	MaxWidth string         `xml:"maxwidth,attr,omitempty"`
+	SizeUnit string         `xml:"size-unit,attr,omitempty"`
+	Source   xliffText      `xml:"source"`
+	Target   *xliff12Target `xml:"target"`
+	Notes    []xliff12Note  `xml:"note"`
+}
+
+type xliff12Target struct {
+	State string `xml:"state,attr,omitempty"`
+	xliffText
+}
+
+type xliff12Note struct {
+	From string `xml:"from,attr,omitempty"`
+	Text string `xml:",chardata"`
+}
+
+// xliffText 源文本或目标文本，不支持行内标记
+type xliffText struct {
+	Text   string        `xml:",chardata"`
+	Inline []xliffInline `xml:",any"`
+}
+
+// xliffInline 行内标记，例如 <x/>、<ph>、<pc>
+type xliffInline struct {
+	XMLName xml.Name
+}
+
+type xliff20Document struct {
+	XMLName xml.Name      `xml:"urn:oasis:names:tc:xliff:document:2.0 xliff"`
+	Version string        `xml:"version,attr"`
+	SrcLang string        `xml:"srcLang,attr"`
+	TrgLang string        `xml:"trgLang,attr,omitempty"`
+	Files   []xliff20File `xml:"file"`
+}
+
+type xliff20File struct {
+	ID     string         `xml:"id,attr"`
+	Units  []xliff20Unit  `xml:"unit"`
+	Groups []xliff20Group `xml:"group"`
+}
+
+type xliff20Group struct {
+	ID     string         `xml:"id,attr"`
+	Units  []xliff20Unit  `xml:"unit"`
+	Groups []xliff20Group `xml:"group"`
+}
+
+type xliff20Unit struct {
+	ID       string           `xml:"id,attr"`
+	Name     string           `xml:"name,attr,omitempty"`
+	Notes    *xliff20Notes    `xml:"notes"`
+	Segments []xliff20Segment `xml:"segment"`
+}
+
+// xliff20Notes 注释列表，没有注释时不写出 notes 元素
+type xliff20Notes struct {
+	Notes []xliff20Note `xml:"note"`
+}
+
+type xliff20Note struct {
+	Category string `xml:"category,attr,omitempty"`
+	Text     string `xml:",chardata"`
+}
+
+type xliff20Segment struct {
+	State  string     `xml:"state,attr,omitempty"`
+	Source xliffText  `xml:"source"`
+	Target *xliffText `xml:"target"`
+}
+
+// WriteXLIFF12 将语言目录写出为 XLIFF 1.2 文件，原文为默认语言的翻译。
+// 未翻译的单元状态为 needs-translation，未审核通过的为 needs-review-translation，审核通过的为 final
+func WriteXLIFF12(w io.Writer, c *Catalog) error {
+	file := xliff12File{Original: "messages", SourceLanguage: c.SourceCulture, TargetLanguage: c.Culture, Datatype: "plaintext"}
+	for _, u := range c.xliffUnits() {
+		unit := xliff12Unit{ID: u.id, Resname: u.id, Source: xliffText{Text: u.source}}
+		target := &xliff12Target{State: "needs-translation"}
+		if u.translated {
+			target.Text = u.target
+			target.State = "needs-review-translation"
+			if u.final {
+				target.State = "final"
+			}
+		}
+		unit.Target = target
+		if u.maxLength > 0 {
+			unit.MaxWidth, unit.SizeUnit = strconv.Itoa(int(u.maxLength)), "char"
+		}
+		if u.description != "" {
+			unit.Notes = append(unit.Notes, xliff12Note{From: xliffNoteDescription, Text: u.description})
+		}
+		if u.context != "" {
+			unit.Notes = append(unit.Notes, xliff12Note{From: xliffNoteContext, Text: u.context})
+		}
+		file.Units = append(file.Units, unit)
+	}
+	return writeXML(w, xliff12Document{Version: "1.2", Files: []xliff12File{file}})
+}
+
+// WriteXLIFF20 将语言目录写出为 XLIFF 2.0 文件，原文为默认语言的翻译。
+// 未翻译的片段状态为 initial，未审核通过的为 translated，审核通过的为 final
+func WriteXLIFF20(w io.Writer, c *Catalog) error {
+	file := xliff20File{ID: "messages"}
+	for i, u := range c.xliffUnits() {
+		// 2.0 的 ID 必须是 NMTOKEN，资源键名称写在 name 中
+		unit := xliff20Unit{ID: "u" + strconv.Itoa(i+1), Name: u.id}
+		segment := xliff20Segment{State: "initial", Source: xliffText{Text: u.source}}
+		if u.translated {
+			segment.Target = &xliffText{Text: u.target}
+			segment.State = "translated"
+			if u.final {
+				segment.State = "final"
+			}
+		}
+		unit.Segments = []xliff20Segment{segment}
+		var notes []xliff20Note
+		if u.description != "" {
+			notes = append(notes, xliff20Note{Category: xliffNoteDescription, Text: u.description})
+		}
+		if u.context != "" {
+			notes = append(notes, xliff20Note{Category: xliffNoteContext, Text: u.context})
+		}
+		if u.maxLength > 0 {
+			notes = append(notes, xliff20Note{Category: xliffNoteMaxLength, Text: strconv.Itoa(int(u.maxLength))})
+		}
+		if len(notes) > 0 {
+			unit.Notes = &xliff20Notes{Notes: notes}
+		}
+		file.Units = append(file.Units, unit)
+	}
+	return writeXML(w, xliff20Document{Version: "2.0", SrcLang: c.SourceCulture, TrgLang: c.Culture, Files: []xliff20File{file}})
+}
+
+// writeXML 写出带 XML 声明的缩进文档
+func writeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ParseXLIFF 解析 XLIFF 1.2 或 2.0 文件，版本由根元素的命名空间决定。
+// 只读取翻译和原文，注释中的元数据不导入。未翻译状态（1.2 的 new、needs-translation，2.0 的 initial）的单元视为未翻译，
+// 1.2 的 final、signed-off 及 2.0 的 reviewed、final 视为审核通过，其它有翻译的单元为待定。
+// 复数资源的所有复数形式都已翻译时才视为已翻译。
+func ParseXLIFF(r io.Reader) (*Catalog, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var root struct {
+		XMLName xml.Name
+		Version string `xml:"version,attr"`
+	}
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+	}
+	c := &Catalog{}
+	var units []xliffUnit
+	switch root.XMLName.Space {
+	case xliff12Namespace:
+		var doc xliff12Document
+		if err := xml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+		}
+		for _, f := range doc.Files {
+			c.SourceCulture, c.Culture = f.SourceLanguage, f.TargetLanguage
+			if units, err = appendXLIFF12Units(units, f.Units, f.Groups); err != nil {
+				return nil, err
+			}
+		}
+	case xliff20Namespace:
+		var doc xliff20Document
+		if err := xml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+		}
+		c.SourceCulture, c.Culture = doc.SrcLang, doc.TrgLang
+		for _, f := range doc.Files {
+			if units, err = appendXLIFF20Units(units, f.Units, f.Groups); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported XLIFF namespace %q", ErrSyntax, root.XMLName.Space)
+	}
+	c.fromXLIFF(units)
+	return c, nil
+}
+
+// appendXLIFF12Units 读取 XLIFF 1.2 的翻译单元，包括分组中的单元
+func appendXLIFF12Units(units []xliffUnit, list []xliff12Unit, groups []xliff12Group) ([]xliffUnit, error) {
+	for _, v := range list {
+		if len(v.Source.Inline) > 0 || (v.Target != nil && len(v.Target.Inline) > 0) {
+			return nil, fmt.Errorf("%w: unit %s: inline markup is not supported", ErrSyntax, v.ID)
+		}
+		unit := xliffUnit{id: v.ID, source: v.Source.Text}
+		if v.Resname != "" {
+			unit.id = v.Resname
+		}
+		if v.Target != nil {
+			unit.target = v.Target.Text
+			switch v.Target.State {
+			case "new", "needs-translation":
+			case "":
+				unit.translated = v.Target.Text != ""
+			case "final", "signed-off":
+				unit.translated, unit.final = true, true
+			default:
+				unit.translated = true
+			}
+		}
+		units = append(units, unit)
+	}
+	var err error
+	for _, g := range groups {
+		if units, err = appendXLIFF12Units(units, g.Units, g.Groups); err != nil {
+			return nil, err
+		}
+	}
+	return units, nil
+}
+
+// appendXLIFF20Units 读取 XLIFF 2.0 的翻译单元，包括分组中的单元，多个片段的文本按顺序拼接
+func appendXLIFF20Units(units []xliffUnit, list []xliff20Unit, groups []xliff20Group) ([]xliffUnit, error) {
+	for _, v := range list {
+		unit := xliffUnit{id: v.ID, translated: len(v.Segments) > 0, final: true}
+		if v.Name != "" {
+			unit.id = v.Name
+		}
+		for _, s := range v.Segments {
+			if len(s.Source.Inline) > 0 || (s.Target != nil && len(s.Target.Inline) > 0) {
+				return nil, fmt.Errorf("%w: unit %s: inline markup is not supported", ErrSyntax, v.ID)
+			}
+			unit.source += s.Source.Text
+			state := s.State
+			if s.Target == nil {
+				state = "initial"
+			} else {
+				unit.target += s.Target.Text
+				if state == "" {
+					state = "translated"
+				}
+			}
+			switch state {
+			case "initial":
+				unit.translated = false
+			case "translated":
+				unit.final = false
+			}
+		}
+		units = append(units, unit)
+	}
+	var err error
+	for _, g := range groups {
+		if units, err = appendXLIFF20Units(units, g.Units, g.Groups); err != nil {
+			return nil, err
+		}
+	}
+	return units, nil
+}
+
+// fromXLIFF 将翻译单元合并为资源项，复数形式的单元合并到同一个资源项
+func (c *Catalog) fromXLIFF(units []xliffUnit) {
+	index := make(map[string]int)
+	incomplete := make(map[string]bool)
+	for _, u := range units {
+		key, category := u.id, ""
+		if m := xliffPluralID.FindStringSubmatch(u.id); m != nil {
+			key, category = m[1], m[2]
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(c.Entries)
+			index[key] = i
+			c.Entries = append(c.Entries, Entry{Key: key, Plural: category != ""})
+		}
+		e := &c.Entries[i]
+		if category == "" {
+			e.Source = u.source
+			if u.translated {
+				e.Text, e.Fuzzy = u.target, !u.final
+			}
+			continue
+		}
+		if e.SourcePlurals == nil {
+			e.SourcePlurals = make(map[string]string)
+		}
+		e.SourcePlurals[category] = u.source
+		if !u.translated {
+			incomplete[key] = true
+			continue
+		}
+		if e.Plurals == nil {
+			e.Plurals = make(map[string]string)
+		}
+		e.Plurals[category] = u.target
+		e.Fuzzy = e.Fuzzy || !u.final
+	}
+	for i := range c.Entries {
+		e := &c.Entries[i]
+		if !e.Plural {
+			continue
+		}
+		e.Source = e.SourcePlurals["other"]
+		if incomplete[e.Key] {
+			e.Plurals, e.Fuzzy = nil, false
+			continue
+		}
+		e.Text = e.Plurals["other"]
+	}
+}
@@ -6,15 +6,17 @@ require (
 	github.com/apolloconfig/agollo/v4 v4.4.0
 	github.com/go-sql-driver/mysql v1.9.1
 	github.com/jinzhu/copier v0.4.0
+	github.com/pelletier/go-toml/v2 v2.2.3
+	golang.org/x/text v0.21.0
 	google.golang.org/grpc v1.71.1
 	google.golang.org/protobuf v1.36.6
+	xorm.io/builder v0.3.11-0.20220531020008-1bd24a7dc978
 	xorm.io/xorm v1.3.9
 )
 
 require (
 	github.com/fsnotify/fsnotify v1.8.0 // indirect
 	github.com/go-viper/mapstructure/v2 v2.2.1 // indirect
-	github.com/pelletier/go-toml/v2 v2.2.3 // indirect
 	github.com/sagikazarmark/locafero v0.7.0 // indirect
 	github.com/sourcegraph/conc v0.3.0 // indirect
 	github.com/spf13/afero v1.12.0 // indirect
@@ -38,6 +40,4 @@ require (
 	github.com/syndtr/goleveldb v1.0.0 // indirect
 	golang.org/x/net v0.34.0 // indirect
 	golang.org/x/sys v0.29.0 // indirect
-	golang.org/x/text v0.21.0 // indirect
-	xorm.io/builder v0.3.11-0.20220531020008-1bd24a7dc978 // indirect
 )
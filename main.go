@@ -4,11 +4,14 @@ import (
 	"flag"
 	"fmt"
 	"i18n-service/config"
+	"i18n-service/data/repository"
 	"i18n-service/proto"
 	"i18n-service/rpc"
 	"log"
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"google.golang.org/grpc"
 )
@@ -19,6 +22,7 @@ func main() {
 		configPath = "."
 	}
 	inf := flag.String("c", configPath, "config path")
+	autoMigrate := flag.Bool("migrate", true, "run schema migrations at startup")
 	flag.Parse()
 	if *inf == "" {
 		log.Fatalf("config path is required")
@@ -35,6 +39,18 @@ func main() {
 		return
 	}
 	configManager.Start()
+	// migrate 子命令：执行数据库结构迁移后退出，migrate status 查看迁移状态
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(configManager, flag.Arg(1) == "status"); err != nil {
+			log.Fatalf("Failed to migrate database: %v", err)
+		}
+		return
+	}
+	if *autoMigrate {
+		if _, err := repository.Migrate(configManager); err != nil {
+			log.Fatalf("Failed to migrate database: %v", err)
+		}
+	}
 	// 定义命令行参数
 	portPtr := flag.Int("p", 50001, "gRPC service port")
 	flag.Parse()
@@ -53,8 +69,45 @@ func main() {
 
 	proto.RegisterI18NServiceServer(grpcServer, rpcServer)
 
+	// 收到退出信号后停止接收请求，等待处理中的请求完成后关闭仓库
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+		<-sig
+		grpcServer.GracefulStop()
+	}()
+
 	log.Printf("server listening at %v", lis.Addr())
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatal(err)
 	}
+	if err := rpcServer.Close(); err != nil {
+		log.Printf("close repository error: %v", err)
+	}
+}
+
+// runMigrate 执行数据库结构迁移，status 为 true 时只输出每个版本的执行状态
+func runMigrate(configManager *config.ConfigManager, status bool) error {
+	if status {
+		list, err := repository.MigrationStatus(configManager)
+		if err != nil {
+			return err
+		}
+		for _, v := range list {
+			state := "pending"
+			if v.Applied {
+				state = "applied"
+			}
+			fmt.Printf("%4d %-24s %s\n", v.Version, v.Name, state)
+		}
+		return nil
+	}
+	applied, err := repository.Migrate(configManager)
+	for _, v := range applied {
+		fmt.Printf("applied %d %s\n", v.Version, v.Name)
+	}
+	if err == nil && len(applied) == 0 {
+		fmt.Println("database schema is up to date")
+	}
+	return err
 }
@@ -0,0 +1,266 @@
+package msgformat
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+// dateSymbols 一个语言的日期时间格式数据，取自 CLDR 公历数据
+type dateSymbols struct {
+	months      [12]string // 月份全称（格式上下文）
+	monthsAbbr  [12]string // 月份简称
+	weekdays    [7]string  // 星期全称，从星期日开始
+	weekdayAbbr [7]string  // 星期简称
+	dayPeriods  [2]string  // 上午、下午
+	dateStyles  map[string]string
+	timeStyles  map[string]string
+}
+
+// 英文名称，未收录的语言也使用
+var (
+	englishMonths      = [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+	englishMonthsAbbr  = [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+	englishWeekdays    = [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	englishWeekdayAbbr = [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+)
+
+// 24 小时制的时间样式，大多数语言相同
+var time24Styles = map[string]string{"full": "HH:mm:ss zzzz", "long": "HH:mm:ss z", "medium": "HH:mm:ss", "short": "HH:mm"}
+
+// rootSymbols 未收录的语言使用 CLDR root 的日期样式，年月日按 ISO 8601 顺序排列，名称使用英文
+var rootSymbols = &dateSymbols{
+	months: englishMonths, monthsAbbr: englishMonthsAbbr, weekdays: englishWeekdays, weekdayAbbr: englishWeekdayAbbr,
+	dayPeriods: [2]string{"AM", "PM"},
+	dateStyles: map[string]string{"full": "y MMMM d, EEEE", "long": "y MMMM d", "medium": "y MMM d", "short": "y-MM-dd"},
+	timeStyles: time24Styles,
+}
+
+// localeSymbols 按基础语言收录的日期时间格式，地区变体使用基础语言的格式
+var localeSymbols = map[string]*dateSymbols{
+	"en": {
+		months: englishMonths, monthsAbbr: englishMonthsAbbr, weekdays: englishWeekdays, weekdayAbbr: englishWeekdayAbbr,
+		dayPeriods: [2]string{"AM", "PM"},
+		dateStyles: map[string]string{"full": "EEEE, MMMM d, y", "long": "MMMM d, y", "medium": "MMM d, y", "short": "M/d/yy"},
+		timeStyles: map[string]string{"full": "h:mm:ss a zzzz", "long": "h:mm:ss a z", "medium": "h:mm:ss a", "short": "h:mm a"},
+	},
+	"de": {
+		months:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		monthsAbbr:  [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		weekdays:    [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		weekdayAbbr: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		dayPeriods:  [2]string{"AM", "PM"},
+		dateStyles:  map[string]string{"full": "EEEE, d. MMMM y", "long": "d. MMMM y", "medium": "dd.MM.y", "short": "dd.MM.yy"},
+		timeStyles:  time24Styles,
+	},
+	"fr": {
+		months:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		monthsAbbr:  [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		weekdays:    [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		weekdayAbbr: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		dayPeriods:  [2]string{"AM", "PM"},
+		dateStyles:  map[string]string{"full": "EEEE d MMMM y", "long": "d MMMM y", "medium": "d MMM y", "short": "dd/MM/y"},
+		timeStyles:  time24Styles,
+	},
+	"es": {
+		months:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		monthsAbbr:  [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		weekdays:    [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		weekdayAbbr: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		dayPeriods:  [2]string{"a. m.", "p. m."},
+		dateStyles:  map[string]string{"full": "EEEE, d 'de' MMMM 'de' y", "long": "d 'de' MMMM 'de' y", "medium": "d MMM y", "short": "d/M/yy"},
+		timeStyles:  map[string]string{"full": "H:mm:ss (zzzz)", "long": "H:mm:ss z", "medium": "H:mm:ss", "short": "H:mm"},
+	},
+	"it": {
+		months:      [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		monthsAbbr:  [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		weekdays:    [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		weekdayAbbr: [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		dayPeriods:  [2]string{"AM", "PM"},
+		dateStyles:  map[string]string{"full": "EEEE d MMMM y", "long": "d MMMM y", "medium": "d MMM y", "short": "dd/MM/yy"},
+		timeStyles:  time24Styles,
+	},
+	"pt": {
+		months:      [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		monthsAbbr:  [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+		weekdays:    [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		weekdayAbbr: [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+		dayPeriods:  [2]string{"AM", "PM"},
+		dateStyles:  map[string]string{"full": "EEEE, d 'de' MMMM 'de' y", "long": "d 'de' MMMM 'de' y", "medium": "d 'de' MMM 'de' y", "short": "dd/MM/y"},
+		timeStyles:  time24Styles,
+	},
+	"ru": {
+		months:      [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+		monthsAbbr:  [12]string{"янв.", "февр.", "мар.", "апр.", "мая", "июн.", "июл.", "авг.", "сент.", "окт.", "нояб.", "дек."},
+		weekdays:    [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+		weekdayAbbr: [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+		dayPeriods:  [2]string{"AM", "PM"},
+		dateStyles:  map[string]string{"full": "EEEE, d MMMM y 'г'.", "long": "d MMMM y 'г'.", "medium": "d MMM y 'г'.", "short": "dd.MM.y"},
+		timeStyles:  time24Styles,
+	},
+	"ja": {
+		months:      [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		monthsAbbr:  [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		weekdays:    [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		weekdayAbbr: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		dayPeriods:  [2]string{"午前", "午後"},
+		dateStyles:  map[string]string{"full": "y年M月d日EEEE", "long": "y年M月d日", "medium": "y/MM/dd", "short": "y/MM/dd"},
+		timeStyles:  map[string]string{"full": "H時mm分ss秒 zzzz", "long": "H:mm:ss z", "medium": "H:mm:ss", "short": "H:mm"},
+	},
+	"zh": {
+		months:      [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+		monthsAbbr:  [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		weekdays:    [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		weekdayAbbr: [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		dayPeriods:  [2]string{"上午", "下午"},
+		dateStyles:  map[string]string{"full": "y年M月d日EEEE", "long": "y年M月d日", "medium": "y年M月d日", "short": "y/M/d"},
+		timeStyles:  map[string]string{"full": "zzzz HH:mm:ss", "long": "z HH:mm:ss", "medium": "HH:mm:ss", "short": "HH:mm"},
+	},
+	"ko": {
+		months:      [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		monthsAbbr:  [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		weekdays:    [7]string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"},
+		weekdayAbbr: [7]string{"일", "월", "화", "수", "목", "금", "토"},
+		dayPeriods:  [2]string{"오전", "오후"},
+		dateStyles:  map[string]string{"full": "y년 MMMM d일 EEEE", "long": "y년 MMMM d일", "medium": "y. M. d.", "short": "yy. M. d."},
+		timeStyles:  map[string]string{"full": "a h시 m분 s초 zzzz", "long": "a h시 m분 s초 z", "medium": "a h:mm:ss", "short": "a h:mm"},
+	},
+	"ar": {
+		months:      [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+		monthsAbbr:  [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+		weekdays:    [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		weekdayAbbr: [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		dayPeriods:  [2]string{"ص", "م"},
+		dateStyles:  map[string]string{"full": "EEEE، d MMMM y", "long": "d MMMM y", "medium": "dd‏/MM‏/y", "short": "d‏/M‏/y"},
+		timeStyles:  map[string]string{"full": "h:mm:ss a zzzz", "long": "h:mm:ss a z", "medium": "h:mm:ss a", "short": "h:mm a"},
+	},
+}
+
+// symbolsFor 获取语言的日期时间格式，按基础语言匹配，未收录时使用 root
+func symbolsFor(tag language.Tag) *dateSymbols {
+	base, _ := tag.Base()
+	if s, ok := localeSymbols[base.String()]; ok {
+		return s
+	}
+	return rootSymbols
+}
+
+// datePattern 根据参数类型和样式获取 ICU 日期模式，样式为空时使用 medium，其他样式视为 ICU 模式
+func datePattern(symbols *dateSymbols, typ string, style string) string {
+	styles := symbols.dateStyles
+	if typ == typeTime {
+		styles = symbols.timeStyles
+	}
+	if style == "" {
+		style = "medium"
+	}
+	if pattern, ok := styles[style]; ok {
+		return pattern
+	}
+	return style
+}
+
+// formatDate 按语言和 ICU 日期模式（如 yyyy-MM-dd HH:mm）格式化时间。
+// 月份、星期和上下午使用语言的名称，数字按语言的数字系统输出；单引号包围的文本为字面文本，两个单引号表示一个单引号。
+func (f *formatter) formatDate(t time.Time, symbols *dateSymbols, pattern string) (string, error) {
+	var b strings.Builder
+	src := []rune(pattern)
+	for i := 0; i < len(src); {
+		ch := src[i]
+		if ch == '\'' {
+			if i+1 < len(src) && src[i+1] == '\'' {
+				b.WriteRune('\'')
+				i += 2
+				continue
+			}
+			end := i + 1
+			for end < len(src) && src[end] != '\'' {
+				end++
+			}
+			b.WriteString(string(src[i+1 : end]))
+			i = end + 1
+			continue
+		}
+		if !(ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z') {
+			b.WriteRune(ch)
+			i++
+			continue
+		}
+		n := 1
+		for i+n < len(src) && src[i+n] == ch {
+			n++
+		}
+		field, err := f.dateField(t, symbols, ch, n)
+		if err != nil {
+			return "", fmt.Errorf("%w in %q", err, pattern)
+		}
+		b.WriteString(field)
+		i += n
+	}
+	return b.String(), nil
+}
+
+// dateField 格式化一个日期模式字段，ch 为字段字母，n 为重复次数
+func (f *formatter) dateField(t time.Time, symbols *dateSymbols, ch rune, n int) (string, error) {
+	switch ch {
+	case 'y':
+		if n == 2 {
+			return f.dateNumber(t.Year()%100, 2), nil
+		}
+		return f.dateNumber(t.Year(), n), nil
+	case 'M', 'L':
+		switch {
+		case n >= 4:
+			return symbols.months[t.Month()-1], nil
+		case n == 3:
+			return symbols.monthsAbbr[t.Month()-1], nil
+		}
+		return f.dateNumber(int(t.Month()), n), nil
+	case 'd':
+		return f.dateNumber(t.Day(), n), nil
+	case 'E':
+		if n >= 4 {
+			return symbols.weekdays[t.Weekday()], nil
+		}
+		return symbols.weekdayAbbr[t.Weekday()], nil
+	case 'H':
+		return f.dateNumber(t.Hour(), n), nil
+	case 'h':
+		h := t.Hour() % 12
+		if h == 0 {
+			h = 12
+		}
+		return f.dateNumber(h, n), nil
+	case 'm':
+		return f.dateNumber(t.Minute(), n), nil
+	case 's':
+		return f.dateNumber(t.Second(), n), nil
+	case 'S':
+		fraction := t.Nanosecond()
+		for digits := 9; digits > n; digits-- {
+			fraction /= 10
+		}
+		return f.dateNumber(fraction, n), nil
+	case 'a':
+		if t.Hour() < 12 {
+			return symbols.dayPeriods[0], nil
+		}
+		return symbols.dayPeriods[1], nil
+	case 'z':
+		return t.Format("MST"), nil
+	case 'Z':
+		if n == 5 {
+			return t.Format("Z07:00"), nil
+		}
+		return t.Format("-0700"), nil
+	}
+	return "", fmt.Errorf("unsupported date pattern field %q", ch)
+}
+
+// dateNumber 按语言的数字系统输出日期中的数字，不足 digits 位时补零，不使用分组分隔符
+func (f *formatter) dateNumber(v int, digits int) string {
+	return f.printer.Sprint(number.Decimal(v, number.MinIntegerDigits(digits), number.NoSeparator()))
+}
@@ -0,0 +1,249 @@
+package msgformat
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// pluralForms CLDR 复数类别名称
+var pluralForms = map[string]plural.Form{
+	"zero":  plural.Zero,
+	"one":   plural.One,
+	"two":   plural.Two,
+	"few":   plural.Few,
+	"many":  plural.Many,
+	"other": plural.Other,
+}
+
+// ArgumentError 参数缺失或类型不匹配
+type ArgumentError struct {
+	Name   string // 参数名
+	Reason string // 错误原因
+}
+
+func (e *ArgumentError) Error() string {
+	return fmt.Sprintf("argument %q: %s", e.Name, e.Reason)
+}
+
+// Format 按语言解析并格式化消息模式。
+// 参数值支持 string、int、int32、int64、float32、float64 和 time.Time。
+func Format(locale string, pattern string, args map[string]interface{}) (string, error) {
+	m, err := Parse(pattern)
+	if err != nil {
+		return "", err
+	}
+	return m.Format(locale, args)
+}
+
+// Format 按语言格式化消息
+func (m *Message) Format(locale string, args map[string]interface{}) (string, error) {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		tag = language.Und
+	}
+	f := &formatter{tag: tag, printer: message.NewPrinter(tag), args: args}
+	var b strings.Builder
+	if err := f.format(&b, m, nil); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+type formatter struct {
+	tag     language.Tag
+	printer *message.Printer
+	args    map[string]interface{}
+}
+
+// pluralValue 当前 plural 分支中 # 对应的数值
+type pluralValue struct {
+	value float64
+}
+
+func (f *formatter) format(b *strings.Builder, m *Message, pound *pluralValue) error {
+	for _, n := range m.nodes {
+		switch n := n.(type) {
+		case textNode:
+			b.WriteString(string(n))
+		case poundNode:
+			if pound == nil {
+				b.WriteByte('#')
+				continue
+			}
+			b.WriteString(f.formatNumber(pound.value, ""))
+		case *argNode:
+			if err := f.formatArgument(b, n); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (f *formatter) formatArgument(b *strings.Builder, arg *argNode) error {
+	value, ok := f.args[arg.name]
+	if !ok {
+		return &ArgumentError{Name: arg.name, Reason: "missing"}
+	}
+	switch arg.typ {
+	case typeNone:
+		b.WriteString(f.formatSimple(value))
+		return nil
+	case typeNumber:
+		n, ok := toFloat(value)
+		if !ok {
+			return &ArgumentError{Name: arg.name, Reason: fmt.Sprintf("expected number, got %T", value)}
+		}
+		if arg.style != "" && arg.style != "integer" && arg.style != "percent" {
+			return &ArgumentError{Name: arg.name, Reason: fmt.Sprintf("unsupported number style %q", arg.style)}
+		}
+		b.WriteString(f.formatNumber(n, arg.style))
+		return nil
+	case typeDate, typeTime:
+		t, ok := value.(time.Time)
+		if !ok {
+			return &ArgumentError{Name: arg.name, Reason: fmt.Sprintf("expected date, got %T", value)}
+		}
+		symbols := symbolsFor(f.tag)
+		text, err := f.formatDate(t, symbols, datePattern(symbols, arg.typ, arg.style))
+		if err != nil {
+			return &ArgumentError{Name: arg.name, Reason: err.Error()}
+		}
+		b.WriteString(text)
+		return nil
+	case typePlural, typeSelectOrdinal:
+		n, ok := toFloat(value)
+		if !ok {
+			return &ArgumentError{Name: arg.name, Reason: fmt.Sprintf("expected number, got %T", value)}
+		}
+		rules := plural.Cardinal
+		if arg.typ == typeSelectOrdinal {
+			rules = plural.Ordinal
+		}
+		msg := selectPlural(arg, rules, f.tag, n)
+		return f.format(b, msg, &pluralValue{value: n - arg.offset})
+	case typeSelect:
+		msg := selectOption(arg, fmt.Sprint(value))
+		return f.format(b, msg, nil)
+	}
+	return &ArgumentError{Name: arg.name, Reason: "unsupported type " + arg.typ}
+}
+
+// formatSimple 格式化未指定类型的参数
+func (f *formatter) formatSimple(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case time.Time:
+		// 与 ICU 一致，未指定类型的日期使用短日期和短时间
+		symbols := symbolsFor(f.tag)
+		date, _ := f.formatDate(v, symbols, symbols.dateStyles["short"])
+		clock, _ := f.formatDate(v, symbols, symbols.timeStyles["short"])
+		return date + " " + clock
+	}
+	if n, ok := toFloat(value); ok {
+		return f.formatNumber(n, "")
+	}
+	return fmt.Sprint(value)
+}
+
+// formatNumber 按语言格式化数字
+func (f *formatter) formatNumber(n float64, style string) string {
+	switch style {
+	case "integer":
+		return f.printer.Sprint(number.Decimal(math.Round(n), number.MaxFractionDigits(0)))
+	case "percent":
+		return f.printer.Sprint(number.Percent(n))
+	}
+	if n == math.Trunc(n) && math.Abs(n) < 1e15 {
+		return f.printer.Sprint(number.Decimal(int64(n)))
+	}
+	return f.printer.Sprint(number.Decimal(n))
+}
+
+// selectPlural 根据数值选择 plural 分支，精确匹配优先于复数类别
+func selectPlural(arg *argNode, rules *plural.Rules, tag language.Tag, n float64) *Message {
+	for _, opt := range arg.options {
+		if strings.HasPrefix(opt.selector, "=") {
+			if exact, err := strconv.ParseFloat(opt.selector[1:], 64); err == nil && exact == n {
+				return opt.message
+			}
+		}
+	}
+	form := PluralForm(rules, tag, n-arg.offset)
+	for _, opt := range arg.options {
+		if pluralForms[opt.selector] == form && !strings.HasPrefix(opt.selector, "=") {
+			return opt.message
+		}
+	}
+	return selectOption(arg, "other")
+}
+
+// selectOption 根据值选择分支，没有匹配时使用 other
+func selectOption(arg *argNode, value string) *Message {
+	var other *Message
+	for _, opt := range arg.options {
+		if opt.selector == value {
+			return opt.message
+		}
+		if opt.selector == "other" {
+			other = opt.message
+		}
+	}
+	return other
+}
+
+// PluralForm 计算数值在指定语言下的复数类别
+func PluralForm(rules *plural.Rules, tag language.Tag, n float64) plural.Form {
+	s := strconv.FormatFloat(math.Abs(n), 'f', -1, 64)
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	i := operand(intPart)
+	trimmed := strings.TrimRight(fracPart, "0")
+	return rules.MatchPlural(tag, i, len(fracPart), len(trimmed), operand(fracPart), operand(trimmed))
+}
+
+// operand 将数字串转换为复数规则操作数，超出范围时取模
+func operand(digits string) int {
+	if len(digits) > 7 {
+		digits = digits[len(digits)-7:]
+	}
+	if digits == "" {
+		return 0
+	}
+	n, _ := strconv.Atoi(digits)
+	return n
+}
+
+// PluralFormName 返回复数类别的名称
+func PluralFormName(form plural.Form) string {
+	for name, v := range pluralForms {
+		if v == form {
+			return name
+		}
+	}
+	return "other"
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
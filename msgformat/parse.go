@@ -0,0 +1,386 @@
+// Package msgformat 实现 ICU MessageFormat 的解析与格式化。
+//
+// 支持的语法：
+//
+//	{name}                              简单参数
+//	{name, number[, integer|percent]}   数字
+//	{name, date[, short|medium|long|full|模式]} 日期
+//	{name, time[, short|medium|long|full|模式]} 时间
+//	{name, plural, [offset:n] =0 {...} one {...} other {...}}
+//	{name, selectordinal, one {...} two {...} few {...} other {...}}
+//	{name, select, male {...} female {...} other {...}}
+//
+// 日期和时间的预定义样式、月份及星期名称取自 CLDR，按基础语言匹配；
+// 未收录的语言使用 CLDR root 的样式（年月日顺序）和英文名称。
+//
+// 撇号按 ICU 规则转义：两个连续的撇号表示一个撇号，'{...}' 表示字面文本。
+package msgformat
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// 参数类型
+const (
+	typeNone          = ""
+	typeNumber        = "number"
+	typeDate          = "date"
+	typeTime          = "time"
+	typePlural        = "plural"
+	typeSelectOrdinal = "selectordinal"
+	typeSelect        = "select"
+)
+
+// SyntaxError 模式语法错误
+type SyntaxError struct {
+	Offset int    // 出错位置（字符偏移）
+	Reason string // 错误原因
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("malformed pattern at offset %d: %s", e.Offset, e.Reason)
+}
+
+// Message 解析后的消息模式
+type Message struct {
+	nodes []node
+}
+
+type node interface{}
+
+// textNode 字面文本
+type textNode string
+
+// poundNode plural 中的 #，表示减去 offset 后的数值
+type poundNode struct{}
+
+// argNode 参数
+type argNode struct {
+	name    string
+	typ     string
+	style   string
+	offset  float64
+	options []option
+}
+
+// option plural/select 的一个分支
+type option struct {
+	selector string
+	message  *Message
+}
+
+// Parse 解析 ICU MessageFormat 模式
+func Parse(pattern string) (*Message, error) {
+	p := &parser{src: []rune(pattern)}
+	m, err := p.parseMessage(false)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unmatched '}'")
+	}
+	return m, nil
+}
+
+// Arguments 返回模式中引用的顶层及嵌套参数名，按首次出现的顺序去重
+func (m *Message) Arguments() []string {
+	var names []string
+	seen := make(map[string]bool)
+	var walk func(*Message)
+	walk = func(msg *Message) {
+		for _, n := range msg.nodes {
+			arg, ok := n.(*argNode)
+			if !ok {
+				continue
+			}
+			if !seen[arg.name] {
+				seen[arg.name] = true
+				names = append(names, arg.name)
+			}
+			for _, opt := range arg.options {
+				walk(opt.message)
+			}
+		}
+	}
+	walk(m)
+	return names
+}
+
+type parser struct {
+	src []rune
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{Offset: p.pos, Reason: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peek() rune {
+	return p.src[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+// parseMessage 解析消息，遇到未配对的 '}' 或结尾时停止，inPlural 表示 # 是否为特殊字符
+func (p *parser) parseMessage(inPlural bool) (*Message, error) {
+	m := &Message{}
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			m.nodes = append(m.nodes, textNode(text.String()))
+			text.Reset()
+		}
+	}
+	for !p.eof() {
+		ch := p.peek()
+		switch {
+		case ch == '\'':
+			p.readApostrophe(&text, inPlural)
+		case ch == '{':
+			flush()
+			arg, err := p.parseArgument()
+			if err != nil {
+				return nil, err
+			}
+			m.nodes = append(m.nodes, arg)
+		case ch == '}':
+			flush()
+			return m, nil
+		case ch == '#' && inPlural:
+			flush()
+			m.nodes = append(m.nodes, poundNode{})
+			p.pos++
+		default:
+			text.WriteRune(ch)
+			p.pos++
+		}
+	}
+	flush()
+	return m, nil
+}
+
+// readApostrophe 处理撇号转义
+func (p *parser) readApostrophe(text *strings.Builder, inPlural bool) {
+	p.pos++
+	if p.eof() {
+		text.WriteRune('\'')
+		return
+	}
+	next := p.peek()
+	if next == '\'' {
+		text.WriteRune('\'')
+		p.pos++
+		return
+	}
+	if next != '{' && next != '}' && next != '|' && !(next == '#' && inPlural) {
+		text.WriteRune('\'')
+		return
+	}
+	// 引号内为字面文本，直到下一个单独的撇号
+	for !p.eof() {
+		ch := p.peek()
+		p.pos++
+		if ch == '\'' {
+			if !p.eof() && p.peek() == '\'' {
+				text.WriteRune('\'')
+				p.pos++
+				continue
+			}
+			return
+		}
+		text.WriteRune(ch)
+	}
+}
+
+// parseArgument 解析 {...} 参数，当前位置为 '{'
+func (p *parser) parseArgument() (*argNode, error) {
+	start := p.pos
+	p.pos++
+	p.skipSpace()
+	name := p.readIdentifier()
+	if name == "" {
+		return nil, p.errorf("missing argument name")
+	}
+	arg := &argNode{name: name}
+	p.skipSpace()
+	if p.eof() {
+		return nil, &SyntaxError{Offset: start, Reason: "unclosed argument {" + name}
+	}
+	if p.peek() == '}' {
+		p.pos++
+		return arg, nil
+	}
+	if p.peek() != ',' {
+		return nil, p.errorf("expected ',' or '}' after argument %q", name)
+	}
+	p.pos++
+	p.skipSpace()
+	arg.typ = p.readIdentifier()
+	p.skipSpace()
+	switch arg.typ {
+	case typeNumber, typeDate, typeTime:
+		if err := p.parseStyle(arg); err != nil {
+			return nil, err
+		}
+	case typePlural, typeSelectOrdinal, typeSelect:
+		if p.eof() || p.peek() != ',' {
+			return nil, p.errorf("expected ',' after %s", arg.typ)
+		}
+		p.pos++
+		if err := p.parseOptions(arg); err != nil {
+			return nil, err
+		}
+	case "":
+		return nil, p.errorf("missing type for argument %q", name)
+	default:
+		return nil, p.errorf("unsupported argument type %q", arg.typ)
+	}
+	return arg, nil
+}
+
+// parseStyle 解析 number/date/time 的样式
+func (p *parser) parseStyle(arg *argNode) error {
+	if p.eof() {
+		return p.errorf("unclosed argument {%s", arg.name)
+	}
+	if p.peek() == '}' {
+		p.pos++
+		return nil
+	}
+	if p.peek() != ',' {
+		return p.errorf("expected ',' or '}' after %s", arg.typ)
+	}
+	p.pos++
+	var style strings.Builder
+	for !p.eof() {
+		ch := p.peek()
+		if ch == '}' {
+			p.pos++
+			arg.style = strings.TrimSpace(style.String())
+			if arg.style == "" {
+				return p.errorf("empty style for argument %q", arg.name)
+			}
+			return nil
+		}
+		if ch == '\'' {
+			p.readApostrophe(&style, false)
+			continue
+		}
+		if ch == '{' {
+			return p.errorf("unexpected '{' in style")
+		}
+		style.WriteRune(ch)
+		p.pos++
+	}
+	return p.errorf("unclosed argument {%s", arg.name)
+}
+
+// parseOptions 解析 plural/select 的分支
+func (p *parser) parseOptions(arg *argNode) error {
+	plural := arg.typ != typeSelect
+	p.skipSpace()
+	if plural && strings.HasPrefix(string(p.src[p.pos:]), "offset:") {
+		p.pos += len("offset:")
+		p.skipSpace()
+		num := p.readIdentifier()
+		offset, err := strconv.ParseFloat(num, 64)
+		if err != nil {
+			return p.errorf("invalid offset %q", num)
+		}
+		arg.offset = offset
+	}
+	seen := make(map[string]bool)
+	for {
+		p.skipSpace()
+		if p.eof() {
+			return p.errorf("unclosed argument {%s", arg.name)
+		}
+		if p.peek() == '}' {
+			p.pos++
+			break
+		}
+		selector := p.readSelector()
+		if selector == "" {
+			return p.errorf("missing selector in %s", arg.typ)
+		}
+		if err := validSelector(selector, plural); err != nil {
+			return p.errorf("%v", err)
+		}
+		if seen[selector] {
+			return p.errorf("duplicate selector %q", selector)
+		}
+		seen[selector] = true
+		p.skipSpace()
+		if p.eof() || p.peek() != '{' {
+			return p.errorf("expected '{' after selector %q", selector)
+		}
+		p.pos++
+		msg, err := p.parseMessage(plural)
+		if err != nil {
+			return err
+		}
+		if p.eof() {
+			return p.errorf("unclosed message for selector %q", selector)
+		}
+		p.pos++
+		arg.options = append(arg.options, option{selector: selector, message: msg})
+	}
+	if !seen["other"] {
+		return p.errorf("%s argument %q requires an 'other' selector", arg.typ, arg.name)
+	}
+	return nil
+}
+
+// readIdentifier 读取参数名、类型名等标识符
+func (p *parser) readIdentifier() string {
+	start := p.pos
+	for !p.eof() {
+		ch := p.peek()
+		if unicode.IsSpace(ch) || strings.ContainsRune("{},#'", ch) {
+			break
+		}
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+// readSelector 读取分支选择器
+func (p *parser) readSelector() string {
+	start := p.pos
+	for !p.eof() {
+		ch := p.peek()
+		if unicode.IsSpace(ch) || ch == '{' || ch == '}' {
+			break
+		}
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+// validSelector 校验选择器是否合法
+func validSelector(selector string, plural bool) error {
+	if !plural {
+		return nil
+	}
+	if strings.HasPrefix(selector, "=") {
+		if _, err := strconv.ParseFloat(selector[1:], 64); err != nil {
+			return fmt.Errorf("invalid explicit selector %q", selector)
+		}
+		return nil
+	}
+	if _, ok := pluralForms[selector]; !ok {
+		return fmt.Errorf("invalid plural selector %q", selector)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package msgformat
+
+import (
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// 复数类别的标准顺序
+var pluralOrder = []string{"zero", "one", "two", "few", "many", "other"}
+
+// 用于探测语言复数类别的整数样本
+var pluralSamples = func() []float64 {
+	var samples []float64
+	for i := 0; i <= 200; i++ {
+		samples = append(samples, float64(i))
+	}
+	return append(samples, 1000, 10000, 100000, 1000000)
+}()
+
+// PluralCategories 返回语言对整数计数必须提供的 CLDR 复数类别，始终包含 other
+func PluralCategories(locale string) []string {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		tag = language.Und
+	}
+	forms := map[plural.Form]bool{plural.Other: true}
+	for _, n := range pluralSamples {
+		forms[PluralForm(plural.Cardinal, tag, n)] = true
+	}
+	var categories []string
+	for _, name := range pluralOrder {
+		if forms[pluralForms[name]] {
+			categories = append(categories, name)
+		}
+	}
+	return categories
+}
+
+// IsPluralCategory 判断是否为合法的 CLDR 复数类别名称
+func IsPluralCategory(name string) bool {
+	_, ok := pluralForms[name]
+	return ok
+}
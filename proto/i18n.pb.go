@@ -9,6 +9,8 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +22,122 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ResourceFormats int32
+
+const (
+	ResourceFormats_Po               ResourceFormats = 0 // gettext PO，msgctxt 为资源类型，导入时 fuzzy 的翻译为草稿
+	ResourceFormats_Pot              ResourceFormats = 1 // gettext POT 模板，只包含资源key
+	ResourceFormats_Xliff12          ResourceFormats = 2 // XLIFF 1.2，原文为默认语言的翻译，导入时不创建资源key
+	ResourceFormats_Xliff20          ResourceFormats = 3 // XLIFF 2.0，原文为默认语言的翻译，导入时不创建资源key
+	ResourceFormats_GoI18nToml       ResourceFormats = 4 // go-i18n v2 TOML 消息文件，只包含已审核通过的翻译，导入时哈希与当前原文不一致的翻译为草稿
+	ResourceFormats_GoI18nJson       ResourceFormats = 5 // go-i18n v2 JSON 消息文件，同 GoI18nToml
+	ResourceFormats_Android          ResourceFormats = 6 // Android strings.xml，只包含已审核通过的翻译，复数资源为 plurals
+	ResourceFormats_AppleStrings     ResourceFormats = 7 // iOS Localizable.strings，只包含已审核通过的非复数资源
+	ResourceFormats_AppleStringsdict ResourceFormats = 8 // iOS Localizable.stringsdict，只包含已审核通过的复数资源
+	ResourceFormats_Resx             ResourceFormats = 9 // .NET RESX，按资源类型导出，只包含已审核通过的翻译，复数资源按复数类别写为 key[one] 等多个资源
+)
+
+// Enum value maps for ResourceFormats.
+var (
+	ResourceFormats_name = map[int32]string{
+		0: "Po",
+		1: "Pot",
+		2: "Xliff12",
+		3: "Xliff20",
+		4: "GoI18nToml",
+		5: "GoI18nJson",
+		6: "Android",
+		7: "AppleStrings",
+		8: "AppleStringsdict",
+		9: "Resx",
+	}
+	ResourceFormats_value = map[string]int32{
+		"Po":               0,
+		"Pot":              1,
+		"Xliff12":          2,
+		"Xliff20":          3,
+		"GoI18nToml":       4,
+		"GoI18nJson":       5,
+		"Android":          6,
+		"AppleStrings":     7,
+		"AppleStringsdict": 8,
+		"Resx":             9,
+	}
+)
+
+func (x ResourceFormats) Enum() *ResourceFormats {
+	p := new(ResourceFormats)
+	*p = x
+	return p
+}
+
+func (x ResourceFormats) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceFormats) Descriptor() protoreflect.EnumDescriptor {
+	return file_i18n_proto_enumTypes[0].Descriptor()
+}
+
+func (ResourceFormats) Type() protoreflect.EnumType {
+	return &file_i18n_proto_enumTypes[0]
+}
+
+func (x ResourceFormats) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceFormats.Descriptor instead.
+func (ResourceFormats) EnumDescriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportIssueTypes int32
+
+const (
+	ImportIssueTypes_UnknownKey    ImportIssueTypes = 0 // 资源key不存在，未导入
+	ImportIssueTypes_SourceChanged ImportIssueTypes = 1 // 导出后默认语言的翻译已修改，翻译导入为草稿
+)
+
+// Enum value maps for ImportIssueTypes.
+var (
+	ImportIssueTypes_name = map[int32]string{
+		0: "UnknownKey",
+		1: "SourceChanged",
+	}
+	ImportIssueTypes_value = map[string]int32{
+		"UnknownKey":    0,
+		"SourceChanged": 1,
+	}
+)
+
+func (x ImportIssueTypes) Enum() *ImportIssueTypes {
+	p := new(ImportIssueTypes)
+	*p = x
+	return p
+}
+
+func (x ImportIssueTypes) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportIssueTypes) Descriptor() protoreflect.EnumDescriptor {
+	return file_i18n_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportIssueTypes) Type() protoreflect.EnumType {
+	return &file_i18n_proto_enumTypes[1]
+}
+
+func (x ImportIssueTypes) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportIssueTypes.Descriptor instead.
+func (ImportIssueTypes) EnumDescriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{1}
+}
+
 type ActionTypes int32
 
 const (
@@ -56,11 +174,11 @@ func (x ActionTypes) String() string {
 }
 
 func (ActionTypes) Descriptor() protoreflect.EnumDescriptor {
-	return file_i18n_proto_enumTypes[0].Descriptor()
+	return file_i18n_proto_enumTypes[2].Descriptor()
 }
 
 func (ActionTypes) Type() protoreflect.EnumType {
-	return &file_i18n_proto_enumTypes[0]
+	return &file_i18n_proto_enumTypes[2]
 }
 
 func (x ActionTypes) Number() protoreflect.EnumNumber {
@@ -69,7 +187,160 @@ func (x ActionTypes) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ActionTypes.Descriptor instead.
 func (ActionTypes) EnumDescriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{0}
+	return file_i18n_proto_rawDescGZIP(), []int{2}
+}
+
+type CultureStatuses int32
+
+const (
+	CultureStatuses_Enabled  CultureStatuses = 0 // 启用
+	CultureStatuses_Beta     CultureStatuses = 1 // 测试，只在显式指定语言代码时提供，不参与 accept-language 协商
+	CultureStatuses_Disabled CultureStatuses = 2 // 停用，只在预览时提供
+)
+
+// Enum value maps for CultureStatuses.
+var (
+	CultureStatuses_name = map[int32]string{
+		0: "Enabled",
+		1: "Beta",
+		2: "Disabled",
+	}
+	CultureStatuses_value = map[string]int32{
+		"Enabled":  0,
+		"Beta":     1,
+		"Disabled": 2,
+	}
+)
+
+func (x CultureStatuses) Enum() *CultureStatuses {
+	p := new(CultureStatuses)
+	*p = x
+	return p
+}
+
+func (x CultureStatuses) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CultureStatuses) Descriptor() protoreflect.EnumDescriptor {
+	return file_i18n_proto_enumTypes[3].Descriptor()
+}
+
+func (CultureStatuses) Type() protoreflect.EnumType {
+	return &file_i18n_proto_enumTypes[3]
+}
+
+func (x CultureStatuses) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CultureStatuses.Descriptor instead.
+func (CultureStatuses) EnumDescriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{3}
+}
+
+type TranslationStatuses int32
+
+const (
+	TranslationStatuses_Draft       TranslationStatuses = 0
+	TranslationStatuses_NeedsReview TranslationStatuses = 1
+	TranslationStatuses_Approved    TranslationStatuses = 2
+	TranslationStatuses_Rejected    TranslationStatuses = 3
+)
+
+// Enum value maps for TranslationStatuses.
+var (
+	TranslationStatuses_name = map[int32]string{
+		0: "Draft",
+		1: "NeedsReview",
+		2: "Approved",
+		3: "Rejected",
+	}
+	TranslationStatuses_value = map[string]int32{
+		"Draft":       0,
+		"NeedsReview": 1,
+		"Approved":    2,
+		"Rejected":    3,
+	}
+)
+
+func (x TranslationStatuses) Enum() *TranslationStatuses {
+	p := new(TranslationStatuses)
+	*p = x
+	return p
+}
+
+func (x TranslationStatuses) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TranslationStatuses) Descriptor() protoreflect.EnumDescriptor {
+	return file_i18n_proto_enumTypes[4].Descriptor()
+}
+
+func (TranslationStatuses) Type() protoreflect.EnumType {
+	return &file_i18n_proto_enumTypes[4]
+}
+
+func (x TranslationStatuses) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TranslationStatuses.Descriptor instead.
+func (TranslationStatuses) EnumDescriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{4}
+}
+
+type ResourceEventTypes int32
+
+const (
+	ResourceEventTypes_Snapshot ResourceEventTypes = 0
+	ResourceEventTypes_Added    ResourceEventTypes = 1
+	ResourceEventTypes_Updated  ResourceEventTypes = 2
+	ResourceEventTypes_Deleted  ResourceEventTypes = 3
+)
+
+// Enum value maps for ResourceEventTypes.
+var (
+	ResourceEventTypes_name = map[int32]string{
+		0: "Snapshot",
+		1: "Added",
+		2: "Updated",
+		3: "Deleted",
+	}
+	ResourceEventTypes_value = map[string]int32{
+		"Snapshot": 0,
+		"Added":    1,
+		"Updated":  2,
+		"Deleted":  3,
+	}
+)
+
+func (x ResourceEventTypes) Enum() *ResourceEventTypes {
+	p := new(ResourceEventTypes)
+	*p = x
+	return p
+}
+
+func (x ResourceEventTypes) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceEventTypes) Descriptor() protoreflect.EnumDescriptor {
+	return file_i18n_proto_enumTypes[5].Descriptor()
+}
+
+func (ResourceEventTypes) Type() protoreflect.EnumType {
+	return &file_i18n_proto_enumTypes[5]
+}
+
+func (x ResourceEventTypes) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceEventTypes.Descriptor instead.
+func (ResourceEventTypes) EnumDescriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{5}
 }
 
 type ReplyCode int32
@@ -84,6 +355,7 @@ const (
 	ReplyCode_InvalidAction ReplyCode = 6
 	ReplyCode_DataExists    ReplyCode = 7
 	ReplyCode_DataNotExists ReplyCode = 8
+	ReplyCode_NotModified   ReplyCode = 9
 )
 
 // Enum value maps for ReplyCode.
@@ -98,6 +370,7 @@ var (
 		6: "InvalidAction",
 		7: "DataExists",
 		8: "DataNotExists",
+		9: "NotModified",
 	}
 	ReplyCode_value = map[string]int32{
 		"Success":       0,
@@ -109,6 +382,7 @@ var (
 		"InvalidAction": 6,
 		"DataExists":    7,
 		"DataNotExists": 8,
+		"NotModified":   9,
 	}
 )
 
@@ -123,11 +397,11 @@ func (x ReplyCode) String() string {
 }
 
 func (ReplyCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_i18n_proto_enumTypes[1].Descriptor()
+	return file_i18n_proto_enumTypes[6].Descriptor()
 }
 
 func (ReplyCode) Type() protoreflect.EnumType {
-	return &file_i18n_proto_enumTypes[1]
+	return &file_i18n_proto_enumTypes[6]
 }
 
 func (x ReplyCode) Number() protoreflect.EnumNumber {
@@ -136,7 +410,7 @@ func (x ReplyCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReplyCode.Descriptor instead.
 func (ReplyCode) EnumDescriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{1}
+	return file_i18n_proto_rawDescGZIP(), []int{6}
 }
 
 type CultureCodeRequest struct {
@@ -144,7 +418,16 @@ type CultureCodeRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Code        string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                    // 语言代码，为空时根据 accept-language 元数据协商
+	TypeIds     []int32  `protobuf:"varint,2,rep,packed,name=type_ids,json=typeIds,proto3" json:"type_ids,omitempty"`       // 按资源类型ID过滤
+	TypeNames   []string `protobuf:"bytes,3,rep,name=type_names,json=typeNames,proto3" json:"type_names,omitempty"`         // 按资源类型名称过滤
+	KeyPrefixes []string `protobuf:"bytes,4,rep,name=key_prefixes,json=keyPrefixes,proto3" json:"key_prefixes,omitempty"`   // 按key前缀过滤，例如 mobile.
+	KeyPatterns []string `protobuf:"bytes,5,rep,name=key_patterns,json=keyPatterns,proto3" json:"key_patterns,omitempty"`   // 按key通配符过滤，* 匹配任意字符，? 匹配单个字符
+	IfNoneMatch string   `protobuf:"bytes,6,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"` // 客户端已有的资源包版本，未变化时返回 NotModified 且不返回资源项
+	Nested      bool     `protobuf:"varint,7,opt,name=nested,proto3" json:"nested,omitempty"`                               // 是否按点分隔的key返回嵌套结构，为 true 时返回 tree 而不返回 items
+	Preview     bool     `protobuf:"varint,8,opt,name=preview,proto3" json:"preview,omitempty"`                             // 是否预览未审核的翻译，为 false 时只返回审核通过的翻译
+	Tags        []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`                                    // 按标签过滤，带有任意一个标签的key匹配
+	Project     string   `protobuf:"bytes,10,opt,name=project,proto3" json:"project,omitempty"`                             // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
 }
 
 func (x *CultureCodeRequest) Reset() {
@@ -186,14 +469,82 @@ func (x *CultureCodeRequest) GetCode() string {
 	return ""
 }
 
+func (x *CultureCodeRequest) GetTypeIds() []int32 {
+	if x != nil {
+		return x.TypeIds
+	}
+	return nil
+}
+
+func (x *CultureCodeRequest) GetTypeNames() []string {
+	if x != nil {
+		return x.TypeNames
+	}
+	return nil
+}
+
+func (x *CultureCodeRequest) GetKeyPrefixes() []string {
+	if x != nil {
+		return x.KeyPrefixes
+	}
+	return nil
+}
+
+func (x *CultureCodeRequest) GetKeyPatterns() []string {
+	if x != nil {
+		return x.KeyPatterns
+	}
+	return nil
+}
+
+func (x *CultureCodeRequest) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
+}
+
+func (x *CultureCodeRequest) GetNested() bool {
+	if x != nil {
+		return x.Nested
+	}
+	return false
+}
+
+func (x *CultureCodeRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+func (x *CultureCodeRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CultureCodeRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
 type CultureResourcesReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items   []*CultureResourceItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Code    ReplyCode              `protobuf:"varint,2,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
-	Message string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Items     []*CultureResourceItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Code      ReplyCode              `protobuf:"varint,2,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message   string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Culture   string                 `protobuf:"bytes,4,opt,name=culture,proto3" json:"culture,omitempty"`     // 实际使用的语言代码
+	Version   string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`     // 资源包版本
+	Tree      *structpb.Struct       `protobuf:"bytes,6,opt,name=tree,proto3" json:"tree,omitempty"`           // 嵌套结构的资源包，例如 {"home": {"title": "..."}}
+	Conflicts []string               `protobuf:"bytes,7,rep,name=conflicts,proto3" json:"conflicts,omitempty"` // 构建嵌套结构时冲突而被忽略的key，例如同时存在 a.b 和 a.b.c
+	Direction string                 `protobuf:"bytes,8,opt,name=direction,proto3" json:"direction,omitempty"` // 实际使用的语言的文字方向 ltr/rtl
 }
 
 func (x *CultureResourcesReply) Reset() {
@@ -249,87 +600,75 @@ func (x *CultureResourcesReply) GetMessage() string {
 	return ""
 }
 
-type CultureResourceItem struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`   // 语言资源key
-	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"` // 语言翻译
-}
-
-func (x *CultureResourceItem) Reset() {
-	*x = CultureResourceItem{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CultureResourcesReply) GetCulture() string {
+	if x != nil {
+		return x.Culture
 	}
+	return ""
 }
 
-func (x *CultureResourceItem) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CultureResourceItem) ProtoMessage() {}
-
-func (x *CultureResourceItem) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CultureResourcesReply) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CultureResourceItem.ProtoReflect.Descriptor instead.
-func (*CultureResourceItem) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{2}
+func (x *CultureResourcesReply) GetTree() *structpb.Struct {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
 }
 
-func (x *CultureResourceItem) GetKey() string {
+func (x *CultureResourcesReply) GetConflicts() []string {
 	if x != nil {
-		return x.Key
+		return x.Conflicts
 	}
-	return ""
+	return nil
 }
 
-func (x *CultureResourceItem) GetText() string {
+func (x *CultureResourcesReply) GetDirection() string {
 	if x != nil {
-		return x.Text
+		return x.Direction
 	}
 	return ""
 }
 
-type CultureBaseReply struct {
+type CultureCodesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code    ReplyCode `protobuf:"varint,1,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
-	Message string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Codes       []string          `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`                                                                                                                          // 语言代码列表
+	TypeIds     []int32           `protobuf:"varint,2,rep,packed,name=type_ids,json=typeIds,proto3" json:"type_ids,omitempty"`                                                                                               // 按资源类型ID过滤
+	TypeNames   []string          `protobuf:"bytes,3,rep,name=type_names,json=typeNames,proto3" json:"type_names,omitempty"`                                                                                                 // 按资源类型名称过滤
+	KeyPrefixes []string          `protobuf:"bytes,4,rep,name=key_prefixes,json=keyPrefixes,proto3" json:"key_prefixes,omitempty"`                                                                                           // 按key前缀过滤，例如 mobile.
+	KeyPatterns []string          `protobuf:"bytes,5,rep,name=key_patterns,json=keyPatterns,proto3" json:"key_patterns,omitempty"`                                                                                           // 按key通配符过滤，* 匹配任意字符，? 匹配单个字符
+	IfNoneMatch map[string]string `protobuf:"bytes,6,rep,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 客户端已有的各语言资源包版本，键为语言代码
+	Nested      bool              `protobuf:"varint,7,opt,name=nested,proto3" json:"nested,omitempty"`                                                                                                                       // 是否按点分隔的key返回嵌套结构
+	Preview     bool              `protobuf:"varint,8,opt,name=preview,proto3" json:"preview,omitempty"`                                                                                                                     // 是否预览未审核的翻译，为 false 时只返回审核通过的翻译
+	Tags        []string          `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`                                                                                                                            // 按标签过滤，带有任意一个标签的key匹配
+	Project     string            `protobuf:"bytes,10,opt,name=project,proto3" json:"project,omitempty"`                                                                                                                     // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
 }
 
-func (x *CultureBaseReply) Reset() {
-	*x = CultureBaseReply{}
+func (x *CultureCodesRequest) Reset() {
+	*x = CultureCodesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[3]
+		mi := &file_i18n_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CultureBaseReply) String() string {
+func (x *CultureCodesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CultureBaseReply) ProtoMessage() {}
+func (*CultureCodesRequest) ProtoMessage() {}
 
-func (x *CultureBaseReply) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[3]
+func (x *CultureCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,124 +679,108 @@ func (x *CultureBaseReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CultureBaseReply.ProtoReflect.Descriptor instead.
-func (*CultureBaseReply) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CultureCodesRequest.ProtoReflect.Descriptor instead.
+func (*CultureCodesRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CultureBaseReply) GetCode() ReplyCode {
+func (x *CultureCodesRequest) GetCodes() []string {
 	if x != nil {
-		return x.Code
+		return x.Codes
 	}
-	return ReplyCode_Success
+	return nil
 }
 
-func (x *CultureBaseReply) GetMessage() string {
+func (x *CultureCodesRequest) GetTypeIds() []int32 {
 	if x != nil {
-		return x.Message
+		return x.TypeIds
 	}
-	return ""
+	return nil
 }
 
-type CulturesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Action    ActionTypes  `protobuf:"varint,1,opt,name=action,proto3,enum=i18n.ActionTypes" json:"action,omitempty"`
-	ParamData *CultureItem `protobuf:"bytes,2,opt,name=param_data,json=paramData,proto3" json:"param_data,omitempty"`
-	Index     int32        `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	Size      int32        `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-}
-
-func (x *CulturesRequest) Reset() {
-	*x = CulturesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CultureCodesRequest) GetTypeNames() []string {
+	if x != nil {
+		return x.TypeNames
 	}
+	return nil
 }
 
-func (x *CulturesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CultureCodesRequest) GetKeyPrefixes() []string {
+	if x != nil {
+		return x.KeyPrefixes
+	}
+	return nil
 }
 
-func (*CulturesRequest) ProtoMessage() {}
-
-func (x *CulturesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CultureCodesRequest) GetKeyPatterns() []string {
+	if x != nil {
+		return x.KeyPatterns
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CulturesRequest.ProtoReflect.Descriptor instead.
-func (*CulturesRequest) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{4}
+func (x *CultureCodesRequest) GetIfNoneMatch() map[string]string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return nil
 }
 
-func (x *CulturesRequest) GetAction() ActionTypes {
+func (x *CultureCodesRequest) GetNested() bool {
 	if x != nil {
-		return x.Action
+		return x.Nested
 	}
-	return ActionTypes_List
+	return false
 }
 
-func (x *CulturesRequest) GetParamData() *CultureItem {
+func (x *CultureCodesRequest) GetPreview() bool {
 	if x != nil {
-		return x.ParamData
+		return x.Preview
 	}
-	return nil
+	return false
 }
 
-func (x *CulturesRequest) GetIndex() int32 {
+func (x *CultureCodesRequest) GetTags() []string {
 	if x != nil {
-		return x.Index
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-func (x *CulturesRequest) GetSize() int32 {
+func (x *CultureCodesRequest) GetProject() string {
 	if x != nil {
-		return x.Size
+		return x.Project
 	}
-	return 0
+	return ""
 }
 
-type CulturesReply struct {
+type CulturesResourcesReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items   []*CultureItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total   int64          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Code    ReplyCode      `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
-	Message string         `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Cultures map[string]*CultureResourcesReply `protobuf:"bytes,1,rep,name=cultures,proto3" json:"cultures,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 各语言的翻译资源，键为请求的语言代码
+	Code     ReplyCode                         `protobuf:"varint,2,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message  string                            `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *CulturesReply) Reset() {
-	*x = CulturesReply{}
+func (x *CulturesResourcesReply) Reset() {
+	*x = CulturesResourcesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[5]
+		mi := &file_i18n_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CulturesReply) String() string {
+func (x *CulturesResourcesReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CulturesReply) ProtoMessage() {}
+func (*CulturesResourcesReply) ProtoMessage() {}
 
-func (x *CulturesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[5]
+func (x *CulturesResourcesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,67 +791,60 @@ func (x *CulturesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CulturesReply.ProtoReflect.Descriptor instead.
-func (*CulturesReply) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CulturesResourcesReply.ProtoReflect.Descriptor instead.
+func (*CulturesResourcesReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CulturesReply) GetItems() []*CultureItem {
+func (x *CulturesResourcesReply) GetCultures() map[string]*CultureResourcesReply {
 	if x != nil {
-		return x.Items
+		return x.Cultures
 	}
 	return nil
 }
 
-func (x *CulturesReply) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *CulturesReply) GetCode() ReplyCode {
+func (x *CulturesResourcesReply) GetCode() ReplyCode {
 	if x != nil {
 		return x.Code
 	}
 	return ReplyCode_Success
 }
 
-func (x *CulturesReply) GetMessage() string {
+func (x *CulturesResourcesReply) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type CultureItem struct {
+type CultureResourceItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                             // 语言名称
-	Code      string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`                             // 语言代码
-	IsDefault bool   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // 是否默认语言
+	Key     string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                                                                                 // 语言资源key
+	Text    string            `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`                                                                                               // 语言翻译
+	Culture string            `protobuf:"bytes,3,opt,name=culture,proto3" json:"culture,omitempty"`                                                                                         // 翻译实际来源的语言代码，为空表示使用了key名
+	Plurals map[string]string `protobuf:"bytes,4,rep,name=plurals,proto3" json:"plurals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 复数形式，键为 CLDR 复数类别 zero/one/two/few/many/other
 }
 
-func (x *CultureItem) Reset() {
-	*x = CultureItem{}
+func (x *CultureResourceItem) Reset() {
+	*x = CultureResourceItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[6]
+		mi := &file_i18n_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CultureItem) String() string {
+func (x *CultureResourceItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CultureItem) ProtoMessage() {}
+func (*CultureResourceItem) ProtoMessage() {}
 
-func (x *CultureItem) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[6]
+func (x *CultureResourceItem) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,68 +855,68 @@ func (x *CultureItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CultureItem.ProtoReflect.Descriptor instead.
-func (*CultureItem) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CultureResourceItem.ProtoReflect.Descriptor instead.
+func (*CultureResourceItem) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CultureItem) GetId() int32 {
+func (x *CultureResourceItem) GetKey() string {
 	if x != nil {
-		return x.Id
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *CultureItem) GetName() string {
+func (x *CultureResourceItem) GetText() string {
 	if x != nil {
-		return x.Name
+		return x.Text
 	}
 	return ""
 }
 
-func (x *CultureItem) GetCode() string {
+func (x *CultureResourceItem) GetCulture() string {
 	if x != nil {
-		return x.Code
+		return x.Culture
 	}
 	return ""
 }
 
-func (x *CultureItem) GetIsDefault() bool {
+func (x *CultureResourceItem) GetPlurals() map[string]string {
 	if x != nil {
-		return x.IsDefault
+		return x.Plurals
 	}
-	return false
+	return nil
 }
 
-type CultureTypesRequest struct {
+type TranslateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action     ActionTypes      `protobuf:"varint,1,opt,name=action,proto3,enum=i18n.ActionTypes" json:"action,omitempty"`
-	ParamData  *CultureTypeItem `protobuf:"bytes,2,opt,name=param_data,json=paramData,proto3" json:"param_data,omitempty"`
-	Index      int32            `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	Size       int32            `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	CultureIds []int32          `protobuf:"varint,5,rep,packed,name=culture_ids,json=cultureIds,proto3" json:"culture_ids,omitempty"`
+	Code      string                      `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                                                                                   // 语言代码，为空时根据 accept-language 元数据协商
+	Keys      []string                    `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`                                                                                                   // 需要翻译的资源key列表
+	Render    bool                        `protobuf:"varint,3,opt,name=render,proto3" json:"render,omitempty"`                                                                                              // 是否按 ICU MessageFormat 渲染文本
+	Arguments map[string]*MessageArgument `protobuf:"bytes,4,rep,name=arguments,proto3" json:"arguments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 渲染参数
+	Project   string                      `protobuf:"bytes,5,opt,name=project,proto3" json:"project,omitempty"`                                                                                             // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
 }
 
-func (x *CultureTypesRequest) Reset() {
-	*x = CultureTypesRequest{}
+func (x *TranslateRequest) Reset() {
+	*x = TranslateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[7]
+		mi := &file_i18n_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CultureTypesRequest) String() string {
+func (x *TranslateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CultureTypesRequest) ProtoMessage() {}
+func (*TranslateRequest) ProtoMessage() {}
 
-func (x *CultureTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[7]
+func (x *TranslateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,74 +927,76 @@ func (x *CultureTypesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CultureTypesRequest.ProtoReflect.Descriptor instead.
-func (*CultureTypesRequest) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use TranslateRequest.ProtoReflect.Descriptor instead.
+func (*TranslateRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CultureTypesRequest) GetAction() ActionTypes {
+func (x *TranslateRequest) GetCode() string {
 	if x != nil {
-		return x.Action
+		return x.Code
 	}
-	return ActionTypes_List
+	return ""
 }
 
-func (x *CultureTypesRequest) GetParamData() *CultureTypeItem {
+func (x *TranslateRequest) GetKeys() []string {
 	if x != nil {
-		return x.ParamData
+		return x.Keys
 	}
 	return nil
 }
 
-func (x *CultureTypesRequest) GetIndex() int32 {
+func (x *TranslateRequest) GetRender() bool {
 	if x != nil {
-		return x.Index
+		return x.Render
 	}
-	return 0
+	return false
 }
 
-func (x *CultureTypesRequest) GetSize() int32 {
+func (x *TranslateRequest) GetArguments() map[string]*MessageArgument {
 	if x != nil {
-		return x.Size
+		return x.Arguments
 	}
-	return 0
+	return nil
 }
 
-func (x *CultureTypesRequest) GetCultureIds() []int32 {
+func (x *TranslateRequest) GetProject() string {
 	if x != nil {
-		return x.CultureIds
+		return x.Project
 	}
-	return nil
+	return ""
 }
 
-type CulturesTypesReply struct {
+type MessageArgument struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items   []*CultureTypeItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total   int64              `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Code    ReplyCode          `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
-	Message string             `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Types that are assignable to Value:
+	//	*MessageArgument_StringValue
+	//	*MessageArgument_IntValue
+	//	*MessageArgument_FloatValue
+	//	*MessageArgument_DateValue
+	Value isMessageArgument_Value `protobuf_oneof:"value"`
 }
 
-func (x *CulturesTypesReply) Reset() {
-	*x = CulturesTypesReply{}
+func (x *MessageArgument) Reset() {
+	*x = MessageArgument{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[8]
+		mi := &file_i18n_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CulturesTypesReply) String() string {
+func (x *MessageArgument) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CulturesTypesReply) ProtoMessage() {}
+func (*MessageArgument) ProtoMessage() {}
 
-func (x *CulturesTypesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[8]
+func (x *MessageArgument) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,66 +1007,104 @@ func (x *CulturesTypesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CulturesTypesReply.ProtoReflect.Descriptor instead.
-func (*CulturesTypesReply) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MessageArgument.ProtoReflect.Descriptor instead.
+func (*MessageArgument) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CulturesTypesReply) GetItems() []*CultureTypeItem {
-	if x != nil {
-		return x.Items
+func (m *MessageArgument) GetValue() isMessageArgument_Value {
+	if m != nil {
+		return m.Value
 	}
 	return nil
 }
 
-func (x *CulturesTypesReply) GetTotal() int64 {
-	if x != nil {
-		return x.Total
+func (x *MessageArgument) GetStringValue() string {
+	if x, ok := x.GetValue().(*MessageArgument_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *MessageArgument) GetIntValue() int64 {
+	if x, ok := x.GetValue().(*MessageArgument_IntValue); ok {
+		return x.IntValue
 	}
 	return 0
 }
 
-func (x *CulturesTypesReply) GetCode() ReplyCode {
-	if x != nil {
-		return x.Code
+func (x *MessageArgument) GetFloatValue() float64 {
+	if x, ok := x.GetValue().(*MessageArgument_FloatValue); ok {
+		return x.FloatValue
 	}
-	return ReplyCode_Success
+	return 0
 }
 
-func (x *CulturesTypesReply) GetMessage() string {
-	if x != nil {
-		return x.Message
+func (x *MessageArgument) GetDateValue() *timestamppb.Timestamp {
+	if x, ok := x.GetValue().(*MessageArgument_DateValue); ok {
+		return x.DateValue
 	}
-	return ""
+	return nil
 }
 
-type CultureTypeItem struct {
+type isMessageArgument_Value interface {
+	isMessageArgument_Value()
+}
+
+type MessageArgument_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type MessageArgument_IntValue struct {
+	IntValue int64 `protobuf:"varint,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type MessageArgument_FloatValue struct {
+	FloatValue float64 `protobuf:"fixed64,3,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type MessageArgument_DateValue struct {
+	DateValue *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_value,json=dateValue,proto3,oneof"`
+}
+
+func (*MessageArgument_StringValue) isMessageArgument_Value() {}
+
+func (*MessageArgument_IntValue) isMessageArgument_Value() {}
+
+func (*MessageArgument_FloatValue) isMessageArgument_Value() {}
+
+func (*MessageArgument_DateValue) isMessageArgument_Value() {}
+
+type TranslateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // 语言资源类型名称
-	Remark string `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"` // 备注
+	Items       []*CultureResourceItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`                                // 翻译结果，按请求顺序返回，culture 为实际来源语言
+	UnknownKeys []string               `protobuf:"bytes,2,rep,name=unknown_keys,json=unknownKeys,proto3" json:"unknown_keys,omitempty"` // 不存在的资源key
+	Culture     string                 `protobuf:"bytes,3,opt,name=culture,proto3" json:"culture,omitempty"`                            // 实际使用的语言代码
+	Code        ReplyCode              `protobuf:"varint,4,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message     string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Errors      map[string]string      `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 渲染失败的key及错误信息，失败的key返回原文
 }
 
-func (x *CultureTypeItem) Reset() {
-	*x = CultureTypeItem{}
+func (x *TranslateReply) Reset() {
+	*x = TranslateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[9]
+		mi := &file_i18n_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CultureTypeItem) String() string {
+func (x *TranslateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CultureTypeItem) ProtoMessage() {}
+func (*TranslateReply) ProtoMessage() {}
 
-func (x *CultureTypeItem) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[9]
+func (x *TranslateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,60 +1115,80 @@ func (x *CultureTypeItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CultureTypeItem.ProtoReflect.Descriptor instead.
-func (*CultureTypeItem) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TranslateReply.ProtoReflect.Descriptor instead.
+func (*TranslateReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CultureTypeItem) GetId() int64 {
+func (x *TranslateReply) GetItems() []*CultureResourceItem {
 	if x != nil {
-		return x.Id
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *CultureTypeItem) GetName() string {
+func (x *TranslateReply) GetUnknownKeys() []string {
 	if x != nil {
-		return x.Name
+		return x.UnknownKeys
+	}
+	return nil
+}
+
+func (x *TranslateReply) GetCulture() string {
+	if x != nil {
+		return x.Culture
 	}
 	return ""
 }
 
-func (x *CultureTypeItem) GetRemark() string {
+func (x *TranslateReply) GetCode() ReplyCode {
 	if x != nil {
-		return x.Remark
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *TranslateReply) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-type CultureKeysRequest struct {
+func (x *TranslateReply) GetErrors() map[string]string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type WatchCultureRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action    ActionTypes     `protobuf:"varint,1,opt,name=action,proto3,enum=i18n.ActionTypes" json:"action,omitempty"`
-	ParamData *CultureKeyItem `protobuf:"bytes,2,opt,name=param_data,json=paramData,proto3" json:"param_data,omitempty"`
-	Index     int32           `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	Size      int32           `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Code        string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                  // 语言代码，为空时根据 accept-language 元数据协商
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // 续传令牌，只对签发的实例在重启前有效，为空或已失效时推送全量快照
+	Project     string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`                            // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
 }
 
-func (x *CultureKeysRequest) Reset() {
-	*x = CultureKeysRequest{}
+func (x *WatchCultureRequest) Reset() {
+	*x = WatchCultureRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[10]
+		mi := &file_i18n_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CultureKeysRequest) String() string {
+func (x *WatchCultureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CultureKeysRequest) ProtoMessage() {}
+func (*WatchCultureRequest) ProtoMessage() {}
 
-func (x *CultureKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[10]
+func (x *WatchCultureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,67 +1199,61 @@ func (x *CultureKeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CultureKeysRequest.ProtoReflect.Descriptor instead.
-func (*CultureKeysRequest) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *CultureKeysRequest) GetAction() ActionTypes {
-	if x != nil {
-		return x.Action
-	}
-	return ActionTypes_List
+// Deprecated: Use WatchCultureRequest.ProtoReflect.Descriptor instead.
+func (*WatchCultureRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CultureKeysRequest) GetParamData() *CultureKeyItem {
+func (x *WatchCultureRequest) GetCode() string {
 	if x != nil {
-		return x.ParamData
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-func (x *CultureKeysRequest) GetIndex() int32 {
+func (x *WatchCultureRequest) GetResumeToken() string {
 	if x != nil {
-		return x.Index
+		return x.ResumeToken
 	}
-	return 0
+	return ""
 }
 
-func (x *CultureKeysRequest) GetSize() int32 {
+func (x *WatchCultureRequest) GetProject() string {
 	if x != nil {
-		return x.Size
+		return x.Project
 	}
-	return 0
+	return ""
 }
 
-type CultureKeysReply struct {
+type CultureResourceEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items   []*CultureKeyItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total   int64             `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Code    ReplyCode         `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
-	Message string            `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Type        ResourceEventTypes     `protobuf:"varint,1,opt,name=type,proto3,enum=i18n.ResourceEventTypes" json:"type,omitempty"`    // 事件类型
+	Items       []*CultureResourceItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                                // 全量快照或变更的资源项
+	ResumeToken string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // 续传令牌
+	Code        ReplyCode              `protobuf:"varint,4,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message     string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *CultureKeysReply) Reset() {
-	*x = CultureKeysReply{}
+func (x *CultureResourceEvent) Reset() {
+	*x = CultureResourceEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[11]
+		mi := &file_i18n_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CultureKeysReply) String() string {
+func (x *CultureResourceEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CultureKeysReply) ProtoMessage() {}
+func (*CultureResourceEvent) ProtoMessage() {}
 
-func (x *CultureKeysReply) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[11]
+func (x *CultureResourceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,67 +1264,71 @@ func (x *CultureKeysReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CultureKeysReply.ProtoReflect.Descriptor instead.
-func (*CultureKeysReply) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CultureResourceEvent.ProtoReflect.Descriptor instead.
+func (*CultureResourceEvent) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CultureKeysReply) GetItems() []*CultureKeyItem {
+func (x *CultureResourceEvent) GetType() ResourceEventTypes {
+	if x != nil {
+		return x.Type
+	}
+	return ResourceEventTypes_Snapshot
+}
+
+func (x *CultureResourceEvent) GetItems() []*CultureResourceItem {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *CultureKeysReply) GetTotal() int64 {
+func (x *CultureResourceEvent) GetResumeToken() string {
 	if x != nil {
-		return x.Total
+		return x.ResumeToken
 	}
-	return 0
+	return ""
 }
 
-func (x *CultureKeysReply) GetCode() ReplyCode {
+func (x *CultureResourceEvent) GetCode() ReplyCode {
 	if x != nil {
 		return x.Code
 	}
 	return ReplyCode_Success
 }
 
-func (x *CultureKeysReply) GetMessage() string {
+func (x *CultureResourceEvent) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type CultureKeyItem struct {
+type CacheStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                         // 语言资源key名称
-	TypeId   int32  `protobuf:"varint,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`      // 语言资源类型ID
-	TypeName string `protobuf:"bytes,4,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"` // 语言资源类型名称
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"` // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
 }
 
-func (x *CultureKeyItem) Reset() {
-	*x = CultureKeyItem{}
+func (x *CacheStatsRequest) Reset() {
+	*x = CacheStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[12]
+		mi := &file_i18n_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CultureKeyItem) String() string {
+func (x *CacheStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CultureKeyItem) ProtoMessage() {}
+func (*CacheStatsRequest) ProtoMessage() {}
 
-func (x *CultureKeyItem) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[12]
+func (x *CacheStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,68 +1339,49 @@ func (x *CultureKeyItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CultureKeyItem.ProtoReflect.Descriptor instead.
-func (*CultureKeyItem) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *CultureKeyItem) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *CultureKeyItem) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CultureKeyItem) GetTypeId() int32 {
-	if x != nil {
-		return x.TypeId
-	}
-	return 0
+// Deprecated: Use CacheStatsRequest.ProtoReflect.Descriptor instead.
+func (*CacheStatsRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CultureKeyItem) GetTypeName() string {
+func (x *CacheStatsRequest) GetProject() string {
 	if x != nil {
-		return x.TypeName
+		return x.Project
 	}
 	return ""
 }
 
-type CultureKeyValuesRequest struct {
+type CacheStatsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action    ActionTypes          `protobuf:"varint,1,opt,name=action,proto3,enum=i18n.ActionTypes" json:"action,omitempty"`
-	ParamData *CultureKeyValueItem `protobuf:"bytes,2,opt,name=param_data,json=paramData,proto3" json:"param_data,omitempty"`
-	Index     int32                `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	Size      int32                `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	SearchKey string               `protobuf:"bytes,5,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"` // 搜索Key名
+	Hits      int64     `protobuf:"varint,1,opt,name=hits,proto3" json:"hits,omitempty"`           // 命中次数
+	Misses    int64     `protobuf:"varint,2,opt,name=misses,proto3" json:"misses,omitempty"`       // 未命中次数
+	Evictions int64     `protobuf:"varint,3,opt,name=evictions,proto3" json:"evictions,omitempty"` // 淘汰次数
+	Entries   int32     `protobuf:"varint,4,opt,name=entries,proto3" json:"entries,omitempty"`     // 当前缓存的语言快照数
+	Bytes     int64     `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`         // 当前缓存估算占用字节数
+	Code      ReplyCode `protobuf:"varint,6,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message   string    `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *CultureKeyValuesRequest) Reset() {
-	*x = CultureKeyValuesRequest{}
+func (x *CacheStatsReply) Reset() {
+	*x = CacheStatsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[13]
+		mi := &file_i18n_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CultureKeyValuesRequest) String() string {
+func (x *CacheStatsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CultureKeyValuesRequest) ProtoMessage() {}
+func (*CacheStatsReply) ProtoMessage() {}
 
-func (x *CultureKeyValuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[13]
+func (x *CacheStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1037,74 +1392,86 @@ func (x *CultureKeyValuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CultureKeyValuesRequest.ProtoReflect.Descriptor instead.
-func (*CultureKeyValuesRequest) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CacheStatsReply.ProtoReflect.Descriptor instead.
+func (*CacheStatsReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CultureKeyValuesRequest) GetAction() ActionTypes {
+func (x *CacheStatsReply) GetHits() int64 {
 	if x != nil {
-		return x.Action
+		return x.Hits
 	}
-	return ActionTypes_List
+	return 0
 }
 
-func (x *CultureKeyValuesRequest) GetParamData() *CultureKeyValueItem {
+func (x *CacheStatsReply) GetMisses() int64 {
 	if x != nil {
-		return x.ParamData
+		return x.Misses
 	}
-	return nil
+	return 0
 }
 
-func (x *CultureKeyValuesRequest) GetIndex() int32 {
+func (x *CacheStatsReply) GetEvictions() int64 {
 	if x != nil {
-		return x.Index
+		return x.Evictions
 	}
 	return 0
 }
 
-func (x *CultureKeyValuesRequest) GetSize() int32 {
+func (x *CacheStatsReply) GetEntries() int32 {
 	if x != nil {
-		return x.Size
+		return x.Entries
 	}
 	return 0
 }
 
-func (x *CultureKeyValuesRequest) GetSearchKey() string {
+func (x *CacheStatsReply) GetBytes() int64 {
 	if x != nil {
-		return x.SearchKey
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *CacheStatsReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *CacheStatsReply) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-type CultureKeyValuesReply struct {
+type CultureBaseReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items   []*CultureKeyValueItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total   int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Code    ReplyCode              `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
-	Message string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Code    ReplyCode `protobuf:"varint,1,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *CultureKeyValuesReply) Reset() {
-	*x = CultureKeyValuesReply{}
+func (x *CultureBaseReply) Reset() {
+	*x = CultureBaseReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[14]
+		mi := &file_i18n_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CultureKeyValuesReply) String() string {
+func (x *CultureBaseReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CultureKeyValuesReply) ProtoMessage() {}
+func (*CultureBaseReply) ProtoMessage() {}
 
-func (x *CultureKeyValuesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[14]
+func (x *CultureBaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,67 +1482,54 @@ func (x *CultureKeyValuesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CultureKeyValuesReply.ProtoReflect.Descriptor instead.
-func (*CultureKeyValuesReply) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *CultureKeyValuesReply) GetItems() []*CultureKeyValueItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
-}
-
-func (x *CultureKeyValuesReply) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use CultureBaseReply.ProtoReflect.Descriptor instead.
+func (*CultureBaseReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CultureKeyValuesReply) GetCode() ReplyCode {
+func (x *CultureBaseReply) GetCode() ReplyCode {
 	if x != nil {
 		return x.Code
 	}
 	return ReplyCode_Success
 }
 
-func (x *CultureKeyValuesReply) GetMessage() string {
+func (x *CultureBaseReply) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type CultureKeyValueItem struct {
+type CulturesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CultureId int32  `protobuf:"varint,2,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"` // 语言ID
-	KeyId     int32  `protobuf:"varint,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`             // 语言资源key ID
-	Text      string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                             // 语言翻译
+	Action    ActionTypes  `protobuf:"varint,1,opt,name=action,proto3,enum=i18n.ActionTypes" json:"action,omitempty"`
+	ParamData *CultureItem `protobuf:"bytes,2,opt,name=param_data,json=paramData,proto3" json:"param_data,omitempty"`
+	Index     int32        `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Size      int32        `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Project   string       `protobuf:"bytes,5,opt,name=project,proto3" json:"project,omitempty"` // 项目代码，列表时只返回项目启用的语言
 }
 
-func (x *CultureKeyValueItem) Reset() {
-	*x = CultureKeyValueItem{}
+func (x *CulturesRequest) Reset() {
+	*x = CulturesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[15]
+		mi := &file_i18n_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CultureKeyValueItem) String() string {
+func (x *CulturesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CultureKeyValueItem) ProtoMessage() {}
+func (*CulturesRequest) ProtoMessage() {}
 
-func (x *CultureKeyValueItem) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[15]
+func (x *CulturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1186,66 +1540,74 @@ func (x *CultureKeyValueItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CultureKeyValueItem.ProtoReflect.Descriptor instead.
-func (*CultureKeyValueItem) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CulturesRequest.ProtoReflect.Descriptor instead.
+func (*CulturesRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CultureKeyValueItem) GetId() int64 {
+func (x *CulturesRequest) GetAction() ActionTypes {
 	if x != nil {
-		return x.Id
+		return x.Action
 	}
-	return 0
+	return ActionTypes_List
 }
 
-func (x *CultureKeyValueItem) GetCultureId() int32 {
+func (x *CulturesRequest) GetParamData() *CultureItem {
 	if x != nil {
-		return x.CultureId
+		return x.ParamData
+	}
+	return nil
+}
+
+func (x *CulturesRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
 	}
 	return 0
 }
 
-func (x *CultureKeyValueItem) GetKeyId() int32 {
+func (x *CulturesRequest) GetSize() int32 {
 	if x != nil {
-		return x.KeyId
+		return x.Size
 	}
 	return 0
 }
 
-func (x *CultureKeyValueItem) GetText() string {
+func (x *CulturesRequest) GetProject() string {
 	if x != nil {
-		return x.Text
+		return x.Project
 	}
 	return ""
 }
 
-type AddCultureKeyValueRequest struct {
+type CulturesReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key    string             `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                      // 语言资源key
-	Values []*CultureKeyValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`                // 语言翻译
-	TypeId int32              `protobuf:"varint,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"` // 语言资源类型ID
+	Items   []*CultureItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total   int64          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Code    ReplyCode      `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message string         `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *AddCultureKeyValueRequest) Reset() {
-	*x = AddCultureKeyValueRequest{}
+func (x *CulturesReply) Reset() {
+	*x = CulturesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[16]
+		mi := &file_i18n_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddCultureKeyValueRequest) String() string {
+func (x *CulturesReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCultureKeyValueRequest) ProtoMessage() {}
+func (*CulturesReply) ProtoMessage() {}
 
-func (x *AddCultureKeyValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[16]
+func (x *CulturesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,58 +1618,2856 @@ func (x *AddCultureKeyValueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCultureKeyValueRequest.ProtoReflect.Descriptor instead.
-func (*AddCultureKeyValueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CulturesReply.ProtoReflect.Descriptor instead.
+func (*CulturesReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CulturesReply) GetItems() []*CultureItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CulturesReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CulturesReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *CulturesReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CultureItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                // 语言名称
+	Code       string          `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`                                // 语言代码
+	IsDefault  bool            `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`    // 是否默认语言
+	ParentId   int32           `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`       // 回退父语言ID
+	NativeName string          `protobuf:"bytes,6,opt,name=native_name,json=nativeName,proto3" json:"native_name,omitempty"`  // 本地名称，例如 Deutsch、العربية
+	Direction  string          `protobuf:"bytes,7,opt,name=direction,proto3" json:"direction,omitempty"`                      // 文字方向 ltr/rtl，为空时根据语言代码推断
+	PluralRule string          `protobuf:"bytes,8,opt,name=plural_rule,json=pluralRule,proto3" json:"plural_rule,omitempty"`  // CLDR复数规则使用的语言，为空时使用语言代码
+	Status     CultureStatuses `protobuf:"varint,9,opt,name=status,proto3,enum=i18n.CultureStatuses" json:"status,omitempty"` // 状态
+}
+
+func (x *CultureItem) Reset() {
+	*x = CultureItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureItem) ProtoMessage() {}
+
+func (x *CultureItem) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureItem.ProtoReflect.Descriptor instead.
+func (*CultureItem) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CultureItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CultureItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CultureItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CultureItem) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *CultureItem) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CultureItem) GetNativeName() string {
+	if x != nil {
+		return x.NativeName
+	}
+	return ""
+}
+
+func (x *CultureItem) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *CultureItem) GetPluralRule() string {
+	if x != nil {
+		return x.PluralRule
+	}
+	return ""
+}
+
+func (x *CultureItem) GetStatus() CultureStatuses {
+	if x != nil {
+		return x.Status
+	}
+	return CultureStatuses_Enabled
+}
+
+type CultureProjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action    ActionTypes         `protobuf:"varint,1,opt,name=action,proto3,enum=i18n.ActionTypes" json:"action,omitempty"`
+	ParamData *CultureProjectItem `protobuf:"bytes,2,opt,name=param_data,json=paramData,proto3" json:"param_data,omitempty"`
+	Index     int32               `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Size      int32               `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *CultureProjectsRequest) Reset() {
+	*x = CultureProjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureProjectsRequest) ProtoMessage() {}
+
+func (x *CultureProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureProjectsRequest.ProtoReflect.Descriptor instead.
+func (*CultureProjectsRequest) Descriptor() ([]byte, []int) {
 	return file_i18n_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AddCultureKeyValueRequest) GetKey() string {
+func (x *CultureProjectsRequest) GetAction() ActionTypes {
+	if x != nil {
+		return x.Action
+	}
+	return ActionTypes_List
+}
+
+func (x *CultureProjectsRequest) GetParamData() *CultureProjectItem {
+	if x != nil {
+		return x.ParamData
+	}
+	return nil
+}
+
+func (x *CultureProjectsRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CultureProjectsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type CultureProjectsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items   []*CultureProjectItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total   int64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Code    ReplyCode             `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message string                `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CultureProjectsReply) Reset() {
+	*x = CultureProjectsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureProjectsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureProjectsReply) ProtoMessage() {}
+
+func (x *CultureProjectsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureProjectsReply.ProtoReflect.Descriptor instead.
+func (*CultureProjectsReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CultureProjectsReply) GetItems() []*CultureProjectItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CultureProjectsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CultureProjectsReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *CultureProjectsReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CultureProjectItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code       string  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                                       // 项目代码，请求中通过 project 字段或 x-i18n-project 元数据指定
+	Name       string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                       // 项目名称
+	Remark     string  `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`                                   // 备注
+	CultureIds []int32 `protobuf:"varint,5,rep,packed,name=culture_ids,json=cultureIds,proto3" json:"culture_ids,omitempty"` // 启用的语言ID，为空时启用全部语言
+}
+
+func (x *CultureProjectItem) Reset() {
+	*x = CultureProjectItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureProjectItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureProjectItem) ProtoMessage() {}
+
+func (x *CultureProjectItem) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureProjectItem.ProtoReflect.Descriptor instead.
+func (*CultureProjectItem) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CultureProjectItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CultureProjectItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CultureProjectItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CultureProjectItem) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *CultureProjectItem) GetCultureIds() []int32 {
+	if x != nil {
+		return x.CultureIds
+	}
+	return nil
+}
+
+type CultureTypesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action         ActionTypes      `protobuf:"varint,1,opt,name=action,proto3,enum=i18n.ActionTypes" json:"action,omitempty"`
+	ParamData      *CultureTypeItem `protobuf:"bytes,2,opt,name=param_data,json=paramData,proto3" json:"param_data,omitempty"`
+	Index          int32            `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Size           int32            `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	CultureIds     []int32          `protobuf:"varint,5,rep,packed,name=culture_ids,json=cultureIds,proto3" json:"culture_ids,omitempty"`
+	ReassignTypeId int64            `protobuf:"varint,6,opt,name=reassign_type_id,json=reassignTypeId,proto3" json:"reassign_type_id,omitempty"` // 删除时仍有key引用该类型，则将这些key改为该类型；为空时拒绝删除
+	Project        string           `protobuf:"bytes,7,opt,name=project,proto3" json:"project,omitempty"`                                        // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *CultureTypesRequest) Reset() {
+	*x = CultureTypesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureTypesRequest) ProtoMessage() {}
+
+func (x *CultureTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureTypesRequest.ProtoReflect.Descriptor instead.
+func (*CultureTypesRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CultureTypesRequest) GetAction() ActionTypes {
+	if x != nil {
+		return x.Action
+	}
+	return ActionTypes_List
+}
+
+func (x *CultureTypesRequest) GetParamData() *CultureTypeItem {
+	if x != nil {
+		return x.ParamData
+	}
+	return nil
+}
+
+func (x *CultureTypesRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CultureTypesRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CultureTypesRequest) GetCultureIds() []int32 {
+	if x != nil {
+		return x.CultureIds
+	}
+	return nil
+}
+
+func (x *CultureTypesRequest) GetReassignTypeId() int64 {
+	if x != nil {
+		return x.ReassignTypeId
+	}
+	return 0
+}
+
+func (x *CultureTypesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type CulturesTypesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items   []*CultureTypeItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total   int64              `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Code    ReplyCode          `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message string             `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CulturesTypesReply) Reset() {
+	*x = CulturesTypesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CulturesTypesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CulturesTypesReply) ProtoMessage() {}
+
+func (x *CulturesTypesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CulturesTypesReply.ProtoReflect.Descriptor instead.
+func (*CulturesTypesReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CulturesTypesReply) GetItems() []*CultureTypeItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CulturesTypesReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CulturesTypesReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *CulturesTypesReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CultureTypeItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // 语言资源类型名称
+	Remark string `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"` // 备注
+}
+
+func (x *CultureTypeItem) Reset() {
+	*x = CultureTypeItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureTypeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureTypeItem) ProtoMessage() {}
+
+func (x *CultureTypeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureTypeItem.ProtoReflect.Descriptor instead.
+func (*CultureTypeItem) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CultureTypeItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CultureTypeItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CultureTypeItem) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type CultureKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action    ActionTypes     `protobuf:"varint,1,opt,name=action,proto3,enum=i18n.ActionTypes" json:"action,omitempty"`
+	ParamData *CultureKeyItem `protobuf:"bytes,2,opt,name=param_data,json=paramData,proto3" json:"param_data,omitempty"`
+	Index     int32           `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Size      int32           `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Tags      []string        `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`       // 按标签过滤，带有任意一个标签的key匹配
+	Project   string          `protobuf:"bytes,6,opt,name=project,proto3" json:"project,omitempty"` // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *CultureKeysRequest) Reset() {
+	*x = CultureKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureKeysRequest) ProtoMessage() {}
+
+func (x *CultureKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureKeysRequest.ProtoReflect.Descriptor instead.
+func (*CultureKeysRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CultureKeysRequest) GetAction() ActionTypes {
+	if x != nil {
+		return x.Action
+	}
+	return ActionTypes_List
+}
+
+func (x *CultureKeysRequest) GetParamData() *CultureKeyItem {
+	if x != nil {
+		return x.ParamData
+	}
+	return nil
+}
+
+func (x *CultureKeysRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CultureKeysRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CultureKeysRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CultureKeysRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type CultureKeysReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items   []*CultureKeyItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total   int64             `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Code    ReplyCode         `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message string            `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CultureKeysReply) Reset() {
+	*x = CultureKeysReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureKeysReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureKeysReply) ProtoMessage() {}
+
+func (x *CultureKeysReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureKeysReply.ProtoReflect.Descriptor instead.
+func (*CultureKeysReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CultureKeysReply) GetItems() []*CultureKeyItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CultureKeysReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CultureKeysReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *CultureKeysReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CultureKeyItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                             // 语言资源key名称
+	TypeId       int32    `protobuf:"varint,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`          // 语言资源类型ID
+	TypeName     string   `protobuf:"bytes,4,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`     // 语言资源类型名称
+	Description  string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`               // 描述，例如使用位置
+	Context      string   `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`                       // 给翻译人员的上下文说明
+	MaxLength    int32    `protobuf:"varint,7,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"` // 翻译的最大字符数，0 表示不限制
+	Placeholders []string `protobuf:"bytes,8,rep,name=placeholders,proto3" json:"placeholders,omitempty"`             // 文本中的占位符，例如 {name}
+	Tags         []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`                             // 标签，列表时返回，通过 TagResourceKeys 修改
+}
+
+func (x *CultureKeyItem) Reset() {
+	*x = CultureKeyItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureKeyItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureKeyItem) ProtoMessage() {}
+
+func (x *CultureKeyItem) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureKeyItem.ProtoReflect.Descriptor instead.
+func (*CultureKeyItem) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CultureKeyItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CultureKeyItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CultureKeyItem) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *CultureKeyItem) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *CultureKeyItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CultureKeyItem) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *CultureKeyItem) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *CultureKeyItem) GetPlaceholders() []string {
+	if x != nil {
+		return x.Placeholders
+	}
+	return nil
+}
+
+func (x *CultureKeyItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CultureTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action    ActionTypes     `protobuf:"varint,1,opt,name=action,proto3,enum=i18n.ActionTypes" json:"action,omitempty"`
+	ParamData *CultureTagItem `protobuf:"bytes,2,opt,name=param_data,json=paramData,proto3" json:"param_data,omitempty"`
+	Index     int32           `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Size      int32           `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Project   string          `protobuf:"bytes,5,opt,name=project,proto3" json:"project,omitempty"` // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *CultureTagsRequest) Reset() {
+	*x = CultureTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureTagsRequest) ProtoMessage() {}
+
+func (x *CultureTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureTagsRequest.ProtoReflect.Descriptor instead.
+func (*CultureTagsRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CultureTagsRequest) GetAction() ActionTypes {
+	if x != nil {
+		return x.Action
+	}
+	return ActionTypes_List
+}
+
+func (x *CultureTagsRequest) GetParamData() *CultureTagItem {
+	if x != nil {
+		return x.ParamData
+	}
+	return nil
+}
+
+func (x *CultureTagsRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CultureTagsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CultureTagsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type CultureTagsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items   []*CultureTagItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total   int64             `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Code    ReplyCode         `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message string            `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CultureTagsReply) Reset() {
+	*x = CultureTagsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureTagsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureTagsReply) ProtoMessage() {}
+
+func (x *CultureTagsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureTagsReply.ProtoReflect.Descriptor instead.
+func (*CultureTagsReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CultureTagsReply) GetItems() []*CultureTagItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CultureTagsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CultureTagsReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *CultureTagsReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CultureTagItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // 标签名称，例如 release-2026.11
+}
+
+func (x *CultureTagItem) Reset() {
+	*x = CultureTagItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureTagItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureTagItem) ProtoMessage() {}
+
+func (x *CultureTagItem) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureTagItem.ProtoReflect.Descriptor instead.
+func (*CultureTagItem) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CultureTagItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CultureTagItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TagResourceKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyIds     []int32  `protobuf:"varint,1,rep,packed,name=key_ids,json=keyIds,proto3" json:"key_ids,omitempty"`     // 语言资源key ID列表
+	AddTags    []string `protobuf:"bytes,2,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`          // 添加的标签，不存在时自动创建
+	RemoveTags []string `protobuf:"bytes,3,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"` // 移除的标签
+	Project    string   `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`                         // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *TagResourceKeysRequest) Reset() {
+	*x = TagResourceKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagResourceKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagResourceKeysRequest) ProtoMessage() {}
+
+func (x *TagResourceKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagResourceKeysRequest.ProtoReflect.Descriptor instead.
+func (*TagResourceKeysRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TagResourceKeysRequest) GetKeyIds() []int32 {
+	if x != nil {
+		return x.KeyIds
+	}
+	return nil
+}
+
+func (x *TagResourceKeysRequest) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
+}
+
+func (x *TagResourceKeysRequest) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+func (x *TagResourceKeysRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type CultureKeyValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action    ActionTypes           `protobuf:"varint,1,opt,name=action,proto3,enum=i18n.ActionTypes" json:"action,omitempty"`
+	ParamData *CultureKeyValueItem  `protobuf:"bytes,2,opt,name=param_data,json=paramData,proto3" json:"param_data,omitempty"`
+	Index     int32                 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Size      int32                 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	SearchKey string                `protobuf:"bytes,5,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`                    // 搜索Key名
+	Statuses  []TranslationStatuses `protobuf:"varint,6,rep,packed,name=statuses,proto3,enum=i18n.TranslationStatuses" json:"statuses,omitempty"` // 按审核状态过滤
+	Tags      []string              `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`                                               // 按key的标签过滤
+	Project   string                `protobuf:"bytes,8,opt,name=project,proto3" json:"project,omitempty"`                                         // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *CultureKeyValuesRequest) Reset() {
+	*x = CultureKeyValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureKeyValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureKeyValuesRequest) ProtoMessage() {}
+
+func (x *CultureKeyValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureKeyValuesRequest.ProtoReflect.Descriptor instead.
+func (*CultureKeyValuesRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CultureKeyValuesRequest) GetAction() ActionTypes {
+	if x != nil {
+		return x.Action
+	}
+	return ActionTypes_List
+}
+
+func (x *CultureKeyValuesRequest) GetParamData() *CultureKeyValueItem {
+	if x != nil {
+		return x.ParamData
+	}
+	return nil
+}
+
+func (x *CultureKeyValuesRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CultureKeyValuesRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CultureKeyValuesRequest) GetSearchKey() string {
+	if x != nil {
+		return x.SearchKey
+	}
+	return ""
+}
+
+func (x *CultureKeyValuesRequest) GetStatuses() []TranslationStatuses {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *CultureKeyValuesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CultureKeyValuesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type CultureKeyValuesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items   []*CultureKeyValueItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total   int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Code    ReplyCode              `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CultureKeyValuesReply) Reset() {
+	*x = CultureKeyValuesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureKeyValuesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureKeyValuesReply) ProtoMessage() {}
+
+func (x *CultureKeyValuesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureKeyValuesReply.ProtoReflect.Descriptor instead.
+func (*CultureKeyValuesReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CultureKeyValuesReply) GetItems() []*CultureKeyValueItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CultureKeyValuesReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CultureKeyValuesReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *CultureKeyValuesReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CultureKeyValueItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CultureId  int32                  `protobuf:"varint,2,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`        // 语言ID
+	KeyId      int32                  `protobuf:"varint,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`                    // 语言资源key ID
+	Text       string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                                    // 语言翻译
+	Status     TranslationStatuses    `protobuf:"varint,5,opt,name=status,proto3,enum=i18n.TranslationStatuses" json:"status,omitempty"` // 审核状态
+	Reviewer   string                 `protobuf:"bytes,6,opt,name=reviewer,proto3" json:"reviewer,omitempty"`                            // 审核人
+	ReviewedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`      // 审核时间
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // 创建时间
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`         // 更新时间
+}
+
+func (x *CultureKeyValueItem) Reset() {
+	*x = CultureKeyValueItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureKeyValueItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureKeyValueItem) ProtoMessage() {}
+
+func (x *CultureKeyValueItem) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureKeyValueItem.ProtoReflect.Descriptor instead.
+func (*CultureKeyValueItem) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CultureKeyValueItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CultureKeyValueItem) GetCultureId() int32 {
+	if x != nil {
+		return x.CultureId
+	}
+	return 0
+}
+
+func (x *CultureKeyValueItem) GetKeyId() int32 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *CultureKeyValueItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CultureKeyValueItem) GetStatus() TranslationStatuses {
+	if x != nil {
+		return x.Status
+	}
+	return TranslationStatuses_Draft
+}
+
+func (x *CultureKeyValueItem) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *CultureKeyValueItem) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *CultureKeyValueItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CultureKeyValueItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AddCultureKeyValueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string             `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                      // 语言资源key
+	Values  []*CultureKeyValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`                // 语言翻译
+	TypeId  int32              `protobuf:"varint,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"` // 语言资源类型ID，新建key时使用，必须属于当前项目
+	Project string             `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`              // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *AddCultureKeyValueRequest) Reset() {
+	*x = AddCultureKeyValueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCultureKeyValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCultureKeyValueRequest) ProtoMessage() {}
+
+func (x *AddCultureKeyValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCultureKeyValueRequest.ProtoReflect.Descriptor instead.
+func (*AddCultureKeyValueRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddCultureKeyValueRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AddCultureKeyValueRequest) GetValues() []*CultureKeyValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *AddCultureKeyValueRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *AddCultureKeyValueRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type CultureKeyValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CultureId int32               `protobuf:"varint,2,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`                                                                   // 语言ID
+	Text      string              `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                                                                                               // 语言翻译，为空且提供复数形式时使用 other 形式
+	Plurals   map[string]string   `protobuf:"bytes,5,rep,name=plurals,proto3" json:"plurals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 复数形式，键为 CLDR 复数类别，必须包含语言要求的所有类别
+	Status    TranslationStatuses `protobuf:"varint,6,opt,name=status,proto3,enum=i18n.TranslationStatuses" json:"status,omitempty"`                                                            // 审核状态，默认为草稿，只能为草稿或待审核，其他状态按待审核保存
+}
+
+func (x *CultureKeyValue) Reset() {
+	*x = CultureKeyValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CultureKeyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CultureKeyValue) ProtoMessage() {}
+
+func (x *CultureKeyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CultureKeyValue.ProtoReflect.Descriptor instead.
+func (*CultureKeyValue) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CultureKeyValue) GetCultureId() int32 {
+	if x != nil {
+		return x.CultureId
+	}
+	return 0
+}
+
+func (x *CultureKeyValue) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CultureKeyValue) GetPlurals() map[string]string {
+	if x != nil {
+		return x.Plurals
+	}
+	return nil
+}
+
+func (x *CultureKeyValue) GetStatus() TranslationStatuses {
+	if x != nil {
+		return x.Status
+	}
+	return TranslationStatuses_Draft
+}
+
+type TransitionLangsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids      []int64             `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`                              // 语言翻译ID列表
+	Status   TranslationStatuses `protobuf:"varint,2,opt,name=status,proto3,enum=i18n.TranslationStatuses" json:"status,omitempty"` // 目标审核状态
+	Reviewer string              `protobuf:"bytes,3,opt,name=reviewer,proto3" json:"reviewer,omitempty"`                            // 审核人，审核通过或驳回时记录，为空时使用操作人
+	Project  string              `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`                              // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *TransitionLangsRequest) Reset() {
+	*x = TransitionLangsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitionLangsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionLangsRequest) ProtoMessage() {}
+
+func (x *TransitionLangsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionLangsRequest.ProtoReflect.Descriptor instead.
+func (*TransitionLangsRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TransitionLangsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *TransitionLangsRequest) GetStatus() TranslationStatuses {
+	if x != nil {
+		return x.Status
+	}
+	return TranslationStatuses_Draft
+}
+
+func (x *TransitionLangsRequest) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *TransitionLangsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type ResourceHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId      int32   `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`                       // 资源key ID，返回该key及其所有语言翻译的历史
+	TypeId     int32   `protobuf:"varint,2,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`                    // 资源类型ID，key_id 为空时返回该资源类型的历史
+	CultureIds []int32 `protobuf:"varint,3,rep,packed,name=culture_ids,json=cultureIds,proto3" json:"culture_ids,omitempty"` // 只返回这些语言翻译的历史，key 本身的历史始终返回
+	Index      int32   `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Size       int32   `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Project    string  `protobuf:"bytes,6,opt,name=project,proto3" json:"project,omitempty"` // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *ResourceHistoryRequest) Reset() {
+	*x = ResourceHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceHistoryRequest) ProtoMessage() {}
+
+func (x *ResourceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ResourceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResourceHistoryRequest) GetKeyId() int32 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *ResourceHistoryRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *ResourceHistoryRequest) GetCultureIds() []int32 {
+	if x != nil {
+		return x.CultureIds
+	}
+	return nil
+}
+
+func (x *ResourceHistoryRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ResourceHistoryRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ResourceHistoryRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type ResourceHistoryReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items   []*ResourceHistoryItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total   int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Code    ReplyCode              `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ResourceHistoryReply) Reset() {
+	*x = ResourceHistoryReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceHistoryReply) ProtoMessage() {}
+
+func (x *ResourceHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceHistoryReply.ProtoReflect.Descriptor instead.
+func (*ResourceHistoryReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResourceHistoryReply) GetItems() []*ResourceHistoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ResourceHistoryReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ResourceHistoryReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *ResourceHistoryReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResourceHistoryItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Entity    string                 `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`                         // 实体类型 lang/key/type
+	EntityId  int64                  `protobuf:"varint,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`    // 实体ID
+	KeyId     int32                  `protobuf:"varint,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`             // 语言资源key ID
+	CultureId int32                  `protobuf:"varint,5,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"` // 语言ID，语言翻译以外的记录为0
+	Action    string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`                         // 操作 create/update/delete
+	OldValue  string                 `protobuf:"bytes,7,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`     // 修改前的值(JSON)，新增时为空
+	NewValue  string                 `protobuf:"bytes,8,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`     // 修改后的值(JSON)，删除时为空
+	Actor     string                 `protobuf:"bytes,9,opt,name=actor,proto3" json:"actor,omitempty"`                           // 操作人，来自 x-i18n-actor 元数据
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 操作时间
+}
+
+func (x *ResourceHistoryItem) Reset() {
+	*x = ResourceHistoryItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceHistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceHistoryItem) ProtoMessage() {}
+
+func (x *ResourceHistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceHistoryItem.ProtoReflect.Descriptor instead.
+func (*ResourceHistoryItem) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResourceHistoryItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResourceHistoryItem) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *ResourceHistoryItem) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *ResourceHistoryItem) GetKeyId() int32 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *ResourceHistoryItem) GetCultureId() int32 {
+	if x != nil {
+		return x.CultureId
+	}
+	return 0
+}
+
+func (x *ResourceHistoryItem) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ResourceHistoryItem) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ResourceHistoryItem) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *ResourceHistoryItem) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ResourceHistoryItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ResourceTrashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity  string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"` // 实体类型 key/type
+	Index   int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Size    int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Project string `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"` // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *ResourceTrashRequest) Reset() {
+	*x = ResourceTrashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceTrashRequest) ProtoMessage() {}
+
+func (x *ResourceTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceTrashRequest.ProtoReflect.Descriptor instead.
+func (*ResourceTrashRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ResourceTrashRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *ResourceTrashRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ResourceTrashRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ResourceTrashRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type ResourceTrashReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items   []*ResourceTrashItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total   int64                `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Code    ReplyCode            `protobuf:"varint,3,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message string               `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ResourceTrashReply) Reset() {
+	*x = ResourceTrashReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceTrashReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceTrashReply) ProtoMessage() {}
+
+func (x *ResourceTrashReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceTrashReply.ProtoReflect.Descriptor instead.
+func (*ResourceTrashReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ResourceTrashReply) GetItems() []*ResourceTrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ResourceTrashReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ResourceTrashReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *ResourceTrashReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResourceTrashItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Entity    string                 `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`                        // 实体类型 key/type
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                            // 名称
+	TypeId    int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`         // 资源key的资源类型ID
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // 删除时间
+	PurgeAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`       // 永久删除时间，为空表示不会自动清理
+}
+
+func (x *ResourceTrashItem) Reset() {
+	*x = ResourceTrashItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceTrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceTrashItem) ProtoMessage() {}
+
+func (x *ResourceTrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceTrashItem.ProtoReflect.Descriptor instead.
+func (*ResourceTrashItem) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResourceTrashItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResourceTrashItem) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *ResourceTrashItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceTrashItem) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *ResourceTrashItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *ResourceTrashItem) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type RestoreResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity  string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"` // 实体类型 key/type
+	Id      int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"` // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *RestoreResourceRequest) Reset() {
+	*x = RestoreResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResourceRequest) ProtoMessage() {}
+
+func (x *RestoreResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResourceRequest.ProtoReflect.Descriptor instead.
+func (*RestoreResourceRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RestoreResourceRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *RestoreResourceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RestoreResourceRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type RestoreRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`          // 历史记录ID，还原为该版本修改后的值，删除记录还原为删除前的值
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"` // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *RestoreRevisionRequest) Reset() {
+	*x = RestoreRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RestoreRevisionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RestoreRevisionRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type LintPlaceholdersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Codes   []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`     // 只检查这些语言，为空时检查默认语言以外的所有语言
+	Project string   `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"` // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *LintPlaceholdersRequest) Reset() {
+	*x = LintPlaceholdersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintPlaceholdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintPlaceholdersRequest) ProtoMessage() {}
+
+func (x *LintPlaceholdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintPlaceholdersRequest.ProtoReflect.Descriptor instead.
+func (*LintPlaceholdersRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *LintPlaceholdersRequest) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *LintPlaceholdersRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type LintPlaceholdersReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items   []*PlaceholderIssue `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // 占位符不一致的翻译，按key和语言排序
+	Code    ReplyCode           `protobuf:"varint,2,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message string              `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *LintPlaceholdersReply) Reset() {
+	*x = LintPlaceholdersReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintPlaceholdersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintPlaceholdersReply) ProtoMessage() {}
+
+func (x *LintPlaceholdersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintPlaceholdersReply.ProtoReflect.Descriptor instead.
+func (*LintPlaceholdersReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LintPlaceholdersReply) GetItems() []*PlaceholderIssue {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *LintPlaceholdersReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *LintPlaceholdersReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PlaceholderIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId      int32    `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"` // 语言资源key ID
+	Key        string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`                   // 语言资源key
+	Culture    string   `protobuf:"bytes,3,opt,name=culture,proto3" json:"culture,omitempty"`           // 语言代码
+	Text       string   `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                 // 语言翻译
+	Reference  string   `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`       // 默认语言的翻译
+	Missing    []string `protobuf:"bytes,6,rep,name=missing,proto3" json:"missing,omitempty"`           // 缺少的占位符，例如 {name}、%s、{{count}}
+	Unexpected []string `protobuf:"bytes,7,rep,name=unexpected,proto3" json:"unexpected,omitempty"`     // 多出的占位符
+	Category   string   `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`         // 不一致的复数类别，为空表示非复数翻译的文本；text 和 reference 为该复数形式的文本
+}
+
+func (x *PlaceholderIssue) Reset() {
+	*x = PlaceholderIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceholderIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceholderIssue) ProtoMessage() {}
+
+func (x *PlaceholderIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceholderIssue.ProtoReflect.Descriptor instead.
+func (*PlaceholderIssue) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PlaceholderIssue) GetKeyId() int32 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *PlaceholderIssue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PlaceholderIssue) GetCulture() string {
+	if x != nil {
+		return x.Culture
+	}
+	return ""
+}
+
+func (x *PlaceholderIssue) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PlaceholderIssue) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *PlaceholderIssue) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *PlaceholderIssue) GetUnexpected() []string {
+	if x != nil {
+		return x.Unexpected
+	}
+	return nil
+}
+
+func (x *PlaceholderIssue) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ExportResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string          `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                // 语言代码，导出模板时忽略
+	Format  ResourceFormats `protobuf:"varint,2,opt,name=format,proto3,enum=i18n.ResourceFormats" json:"format,omitempty"` // 文件格式
+	TypeIds []int32         `protobuf:"varint,3,rep,packed,name=type_ids,json=typeIds,proto3" json:"type_ids,omitempty"`   // 只导出这些资源类型的资源key，为空时导出全部；Resx 格式必须且只能指定一个资源类型
+	Project string          `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`                          // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *ExportResourcesRequest) Reset() {
+	*x = ExportResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResourcesRequest) ProtoMessage() {}
+
+func (x *ExportResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ExportResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportResourcesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ExportResourcesRequest) GetFormat() ResourceFormats {
+	if x != nil {
+		return x.Format
+	}
+	return ResourceFormats_Po
+}
+
+func (x *ExportResourcesRequest) GetTypeIds() []int32 {
+	if x != nil {
+		return x.TypeIds
+	}
+	return nil
+}
+
+func (x *ExportResourcesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type ExportResourcesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content     []byte    `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`                            // 文件内容
+	FileName    string    `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`          // 建议的文件名，例如 de.po、messages.pot、values-de/strings.xml、Web.de.resx
+	ContentType string    `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME 类型
+	Code        ReplyCode `protobuf:"varint,4,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message     string    `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ExportResourcesReply) Reset() {
+	*x = ExportResourcesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResourcesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResourcesReply) ProtoMessage() {}
+
+func (x *ExportResourcesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResourcesReply.ProtoReflect.Descriptor instead.
+func (*ExportResourcesReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportResourcesReply) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportResourcesReply) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportResourcesReply) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportResourcesReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *ExportResourcesReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string              `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                    // 语言代码，为空时使用文件中的语言，导入模板时忽略
+	Format  ResourceFormats     `protobuf:"varint,2,opt,name=format,proto3,enum=i18n.ResourceFormats" json:"format,omitempty"`     // 文件格式
+	Content []byte              `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                              // 文件内容
+	TypeId  int32               `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`                 // 文件中未指定资源类型的新资源key使用的资源类型ID，必须属于当前项目
+	Status  TranslationStatuses `protobuf:"varint,5,opt,name=status,proto3,enum=i18n.TranslationStatuses" json:"status,omitempty"` // 导入的翻译的审核状态，只能为草稿或待审核；文件中标记为待定（例如 fuzzy）及原文已变化的翻译为草稿
+	Project string              `protobuf:"bytes,6,opt,name=project,proto3" json:"project,omitempty"`                              // 项目代码，为空时使用 x-i18n-project 元数据，都为空时为默认项目
+}
+
+func (x *ImportResourcesRequest) Reset() {
+	*x = ImportResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResourcesRequest) ProtoMessage() {}
+
+func (x *ImportResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ImportResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ImportResourcesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ImportResourcesRequest) GetFormat() ResourceFormats {
+	if x != nil {
+		return x.Format
+	}
+	return ResourceFormats_Po
+}
+
+func (x *ImportResourcesRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportResourcesRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *ImportResourcesRequest) GetStatus() TranslationStatuses {
+	if x != nil {
+		return x.Status
+	}
+	return TranslationStatuses_Draft
+}
+
+func (x *ImportResourcesRequest) GetProject() string {
 	if x != nil {
-		return x.Key
+		return x.Project
 	}
 	return ""
 }
 
-func (x *AddCultureKeyValueRequest) GetValues() []*CultureKeyValue {
+type ImportResourcesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeysAdded    int32          `protobuf:"varint,1,opt,name=keys_added,json=keysAdded,proto3" json:"keys_added,omitempty"`          // 新增的资源key数
+	KeysUpdated  int32          `protobuf:"varint,2,opt,name=keys_updated,json=keysUpdated,proto3" json:"keys_updated,omitempty"`    // 修改的资源key数
+	LangsAdded   int32          `protobuf:"varint,3,opt,name=langs_added,json=langsAdded,proto3" json:"langs_added,omitempty"`       // 新增的翻译数
+	LangsUpdated int32          `protobuf:"varint,4,opt,name=langs_updated,json=langsUpdated,proto3" json:"langs_updated,omitempty"` // 修改的翻译数
+	Skipped      int32          `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`                               // 与已有翻译相同而跳过的翻译数
+	Code         ReplyCode      `protobuf:"varint,6,opt,name=code,proto3,enum=i18n.ReplyCode" json:"code,omitempty"`
+	Message      string         `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Issues       []*ImportIssue `protobuf:"bytes,8,rep,name=issues,proto3" json:"issues,omitempty"` // 需要关注的资源项，例如 XLIFF 中不存在的资源key或原文已变化的单元
+}
+
+func (x *ImportResourcesReply) Reset() {
+	*x = ImportResourcesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_i18n_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResourcesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResourcesReply) ProtoMessage() {}
+
+func (x *ImportResourcesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResourcesReply.ProtoReflect.Descriptor instead.
+func (*ImportResourcesReply) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportResourcesReply) GetKeysAdded() int32 {
 	if x != nil {
-		return x.Values
+		return x.KeysAdded
 	}
-	return nil
+	return 0
 }
 
-func (x *AddCultureKeyValueRequest) GetTypeId() int32 {
+func (x *ImportResourcesReply) GetKeysUpdated() int32 {
 	if x != nil {
-		return x.TypeId
+		return x.KeysUpdated
 	}
 	return 0
 }
 
-type CultureKeyValue struct {
+func (x *ImportResourcesReply) GetLangsAdded() int32 {
+	if x != nil {
+		return x.LangsAdded
+	}
+	return 0
+}
+
+func (x *ImportResourcesReply) GetLangsUpdated() int32 {
+	if x != nil {
+		return x.LangsUpdated
+	}
+	return 0
+}
+
+func (x *ImportResourcesReply) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportResourcesReply) GetCode() ReplyCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReplyCode_Success
+}
+
+func (x *ImportResourcesReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportResourcesReply) GetIssues() []*ImportIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type ImportIssue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CultureId int32  `protobuf:"varint,2,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"` // 语言ID
-	Text      string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                             // 语言翻译
+	Key           string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                          // 语言资源key
+	Type          ImportIssueTypes `protobuf:"varint,2,opt,name=type,proto3,enum=i18n.ImportIssueTypes" json:"type,omitempty"`            // 问题类型
+	Source        string           `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                                    // 文件中的原文
+	CurrentSource string           `protobuf:"bytes,4,opt,name=current_source,json=currentSource,proto3" json:"current_source,omitempty"` // 默认语言当前的翻译
 }
 
-func (x *CultureKeyValue) Reset() {
-	*x = CultureKeyValue{}
+func (x *ImportIssue) Reset() {
+	*x = ImportIssue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_i18n_proto_msgTypes[17]
+		mi := &file_i18n_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CultureKeyValue) String() string {
+func (x *ImportIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CultureKeyValue) ProtoMessage() {}
+func (*ImportIssue) ProtoMessage() {}
 
-func (x *CultureKeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_i18n_proto_msgTypes[17]
+func (x *ImportIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1318,21 +4478,35 @@ func (x *CultureKeyValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CultureKeyValue.ProtoReflect.Descriptor instead.
-func (*CultureKeyValue) Descriptor() ([]byte, []int) {
-	return file_i18n_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ImportIssue.ProtoReflect.Descriptor instead.
+func (*ImportIssue) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CultureKeyValue) GetCultureId() int32 {
+func (x *ImportIssue) GetKey() string {
 	if x != nil {
-		return x.CultureId
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *CultureKeyValue) GetText() string {
+func (x *ImportIssue) GetType() ImportIssueTypes {
 	if x != nil {
-		return x.Text
+		return x.Type
+	}
+	return ImportIssueTypes_UnknownKey
+}
+
+func (x *ImportIssue) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportIssue) GetCurrentSource() string {
+	if x != nil {
+		return x.CurrentSource
 	}
 	return ""
 }
@@ -1341,175 +4515,687 @@ var File_i18n_proto protoreflect.FileDescriptor
 
 var file_i18n_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x69, 0x31,
-	0x38, 0x6e, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64,
+	0x38, 0x6e, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xac, 0x02, 0x0a, 0x12, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x87, 0x01, 0x0a,
-	0x15, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c,
-	0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3b, 0x0a, 0x13, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72,
-	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07,
+	0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x65,
+	0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x79,
+	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x6b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0d,
+	0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x22, 0xa4, 0x02, 0x0a, 0x15, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e,
+	0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75,
+	0x6c, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x6c,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x74, 0x72, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x03, 0x0a, 0x13, 0x43, 0x75, 0x6c, 0x74,
+	0x75, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x4e, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x65,
+	0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69,
+	0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x66, 0x4e, 0x6f, 0x6e, 0x65, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x6e, 0x65,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x49, 0x66, 0x4e, 0x6f, 0x6e, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf9, 0x01, 0x0a, 0x16, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x46, 0x0a, 0x08, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x58, 0x0a, 0x0d, 0x43, 0x75, 0x6c, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x31, 0x38, 0x6e,
+	0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xd3, 0x01, 0x0a, 0x13, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x70, 0x6c, 0x75,
+	0x72, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x31, 0x38,
+	0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x50,
+	0x6c, 0x75, 0x72, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x09,
+	0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x53, 0x0a, 0x0e, 0x41,
+	0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x65, 0x78, 0x74, 0x22, 0x51, 0x0a, 0x10, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x42, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x0f, 0x43, 0x75, 0x6c, 0x74, 0x75,
-	0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x63,
+	0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x72, 0x67, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
+	0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xb2, 0x02, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x6c, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x6c, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x38, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x66, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xd7,
+	0x01, 0x0a, 0x14, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2d, 0x0a, 0x11, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x76, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x0f, 0x43, 0x75, 0x6c, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x31,
+	0x38, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x31, 0x38,
+	0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x8d, 0x01, 0x0a,
+	0x0d, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31,
+	0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x90, 0x02, 0x0a,
+	0x0b, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0xa6, 0x01, 0x0a, 0x16, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x31, 0x38,
 	0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x31, 0x38, 0x6e,
-	0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x70, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x31, 0x38, 0x6e,
+	0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x14, 0x43, 0x75, 0x6c,
+	0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x12, 0x43, 0x75, 0x6c, 0x74, 0x75,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x73, 0x22, 0x85,
+	0x02, 0x0a, 0x13, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c,
+	0x74, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x70, 0x61,
 	0x72, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a,
 	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x64, 0x0a, 0x0b, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x13, 0x43, 0x75, 0x6c, 0x74,
-	0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x29, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x11, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75,
-	0x6c, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52,
-	0x0a, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x12,
-	0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x4d, 0x0a, 0x0f, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d,
-	0x61, 0x72, 0x6b, 0x22, 0x9e, 0x01, 0x0a, 0x12, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b,
-	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x43, 0x75, 0x6c, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69,
+	0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x4d, 0x0a, 0x0f, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0xcc,
+	0x01, 0x0a, 0x12, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74,
+	0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x93, 0x01,
+	0x0a, 0x10, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65,
+	0x4b, 0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x0e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b,
+	0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x79, 0x70,
+	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x12, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x31, 0x38,
 	0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x06, 0x61,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x64,
 	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x31, 0x38, 0x6e,
-	0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x54, 0x61, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52,
 	0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
 	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
 	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x10, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65,
-	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e,
-	0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e,
-	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a, 0x0e, 0x43, 0x75,
-	0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x17, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79,
-	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x17, 0x43, 0x75, 0x6c, 0x74, 0x75,
-	0x72, 0x65, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
-	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65,
-	0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79,
-	0x22, 0x9d, 0x01, 0x0a, 0x15, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e,
-	0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x6f, 0x0a, 0x13, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x75, 0x6c, 0x74, 0x75,
-	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x75, 0x6c,
-	0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x22, 0x75, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b,
-	0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x2d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b,
-	0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
-	0x17, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x0f, 0x43, 0x75, 0x6c, 0x74,
-	0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x93,
+	0x01, 0x0a, 0x10, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72,
+	0x65, 0x54, 0x61, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x34, 0x0a, 0x0e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x54,
+	0x61, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x16, 0x54,
+	0x61, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x22, 0xac, 0x02, 0x0a, 0x17, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65,
+	0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x29, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65,
+	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x35,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x15, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b,
+	0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69,
+	0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xf1, 0x02, 0x0a, 0x13, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b,
+	0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
 	0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x2a, 0x3d,
-	0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x08, 0x0a,
-	0x04, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4f, 0x72,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x10, 0x03, 0x2a, 0x9d, 0x01,
-	0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10,
-	0x02, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x61, 0x74, 0x61, 0x42, 0x61, 0x73, 0x65, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x44, 0x61, 0x74, 0x61, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x61,
-	0x74, 0x61, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x61,
-	0x74, 0x61, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x08, 0x32, 0xec, 0x03,
-	0x0a, 0x0b, 0x49, 0x31, 0x38, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a,
-	0x0e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x15, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75,
-	0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x52, 0x0a, 0x1b, 0x43,
-	0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x19, 0x2e, 0x69, 0x31, 0x38,
-	0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x4e, 0x0a, 0x1a, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x2e,
-	0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73,
+	0x09, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x43,
+	0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43,
+	0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x43, 0x75,
+	0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x3c, 0x0a, 0x07, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65,
+	0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x50, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x73, 0x12, 0x31,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x93, 0x01,
+	0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69, 0x31, 0x38,
+	0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x31,
+	0x38, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xb3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12,
+	0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x75, 0x6c, 0x74,
+	0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65,
+	0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x72, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x98, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a,
+	0x08, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x41, 0x74, 0x22, 0x5a, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x22, 0x42, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x22, 0x49, 0x0a, 0x17, 0x4c, 0x69, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22,
+	0x84, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6b,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x65, 0x79,
+	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x14, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38,
+	0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdb, 0x01, 0x0a, 0x16,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x69, 0x31, 0x38,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x73, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x69,
+	0x31, 0x38, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xa2, 0x02, 0x0a, 0x14, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x73, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x73, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x67, 0x73,
+	0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x6e, 0x67, 0x73, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6c, 0x61,
+	0x6e, 0x67, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x8a,
+	0x01, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2a, 0x9b, 0x01, 0x0a, 0x0f,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x50, 0x6f, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x6f, 0x74, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x58, 0x6c, 0x69, 0x66, 0x66, 0x31, 0x32, 0x10, 0x02, 0x12, 0x0b, 0x0a,
+	0x07, 0x58, 0x6c, 0x69, 0x66, 0x66, 0x32, 0x30, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x6f,
+	0x49, 0x31, 0x38, 0x6e, 0x54, 0x6f, 0x6d, 0x6c, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x6f,
+	0x49, 0x31, 0x38, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x6e,
+	0x64, 0x72, 0x6f, 0x69, 0x64, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x65,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x70, 0x70,
+	0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x64, 0x69, 0x63, 0x74, 0x10, 0x08, 0x12,
+	0x08, 0x0a, 0x04, 0x52, 0x65, 0x73, 0x78, 0x10, 0x09, 0x2a, 0x35, 0x0a, 0x10, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x0e, 0x0a,
+	0x0a, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x65, 0x79, 0x10, 0x00, 0x12, 0x11, 0x0a,
+	0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x10, 0x01,
+	0x2a, 0x3d, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x08, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x64, 0x64,
+	0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x10, 0x03, 0x2a,
+	0x36, 0x0a, 0x0f, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x42, 0x65, 0x74, 0x61, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x02, 0x2a, 0x4d, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x09,
+	0x0a, 0x05, 0x44, 0x72, 0x61, 0x66, 0x74, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x65, 0x65,
+	0x64, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x10, 0x03, 0x2a, 0x47, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x0c, 0x0a, 0x08,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x64,
+	0x64, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x03, 0x2a,
+	0xae, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e,
+	0x64, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x61, 0x74, 0x61, 0x42, 0x61, 0x73, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x44, 0x61, 0x74, 0x61, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d,
+	0x44, 0x61, 0x74, 0x61, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x08, 0x12,
+	0x0f, 0x0a, 0x0b, 0x4e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x09,
+	0x32, 0xf7, 0x0c, 0x0a, 0x0b, 0x49, 0x31, 0x38, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x3c, 0x0a, 0x0e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x15, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x69, 0x31, 0x38, 0x6e,
+	0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x52,
+	0x0a, 0x16, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e,
+	0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75,
+	0x6c, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x52, 0x0a, 0x1b, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69,
+	0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4e, 0x0a, 0x1a, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74,
+	0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4e, 0x0a, 0x1a, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x67, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74,
+	0x75, 0x72, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x47, 0x0a, 0x0f, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x2e, 0x69, 0x31, 0x38, 0x6e,
+	0x2e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43,
-	0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
 	0x5d, 0x0a, 0x1f, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75,
 	0x72, 0x65, 0x12, 0x1d, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72,
@@ -1520,14 +5206,72 @@ var file_i18n_proto_rawDesc = []byte{
 	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x41, 0x64, 0x64,
 	0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75,
-	0x6c, 0x74, 0x75, 0x72, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4c,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c,
-	0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x12, 0x5a, 0x07,
-	0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xaa, 0x02, 0x06, 0x47, 0x6f, 0x49, 0x31, 0x38, 0x6e,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x74, 0x75, 0x72, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4f,
+	0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x61, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x2e, 0x69, 0x31, 0x38, 0x6e,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43,
+	0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x4e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x4f, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x69, 0x31, 0x38,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e,
+	0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x48, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x72, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x47, 0x0a, 0x0f, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x2e,
+	0x69, 0x31, 0x38, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x31,
+	0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x4c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x69, 0x31, 0x38,
+	0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x4f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e,
+	0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x47, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x69, 0x31,
+	0x38, 0x6e, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x50, 0x0a, 0x15, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x43, 0x75, 0x6c, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x39, 0x0a,
+	0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x69, 0x31, 0x38,
+	0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x56, 0x0a, 0x18, 0x4c, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x4c, 0x69, 0x6e, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x4b, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4b, 0x0a,
+	0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x1c, 0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x69, 0x31, 0x38, 0x6e, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x12, 0x5a, 0x07, 0x2e, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0xaa, 0x02, 0x06, 0x47, 0x6f, 0x49, 0x31, 0x38, 0x6e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1542,68 +5286,193 @@ func file_i18n_proto_rawDescGZIP() []byte {
 	return file_i18n_proto_rawDescData
 }
 
-var file_i18n_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_i18n_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_i18n_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_i18n_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_i18n_proto_goTypes = []any{
-	(ActionTypes)(0),                  // 0: i18n.ActionTypes
-	(ReplyCode)(0),                    // 1: i18n.ReplyCode
-	(*CultureCodeRequest)(nil),        // 2: i18n.CultureCodeRequest
-	(*CultureResourcesReply)(nil),     // 3: i18n.CultureResourcesReply
-	(*CultureResourceItem)(nil),       // 4: i18n.CultureResourceItem
-	(*CultureBaseReply)(nil),          // 5: i18n.CultureBaseReply
-	(*CulturesRequest)(nil),           // 6: i18n.CulturesRequest
-	(*CulturesReply)(nil),             // 7: i18n.CulturesReply
-	(*CultureItem)(nil),               // 8: i18n.CultureItem
-	(*CultureTypesRequest)(nil),       // 9: i18n.CultureTypesRequest
-	(*CulturesTypesReply)(nil),        // 10: i18n.CulturesTypesReply
-	(*CultureTypeItem)(nil),           // 11: i18n.CultureTypeItem
-	(*CultureKeysRequest)(nil),        // 12: i18n.CultureKeysRequest
-	(*CultureKeysReply)(nil),          // 13: i18n.CultureKeysReply
-	(*CultureKeyItem)(nil),            // 14: i18n.CultureKeyItem
-	(*CultureKeyValuesRequest)(nil),   // 15: i18n.CultureKeyValuesRequest
-	(*CultureKeyValuesReply)(nil),     // 16: i18n.CultureKeyValuesReply
-	(*CultureKeyValueItem)(nil),       // 17: i18n.CultureKeyValueItem
-	(*AddCultureKeyValueRequest)(nil), // 18: i18n.AddCultureKeyValueRequest
-	(*CultureKeyValue)(nil),           // 19: i18n.CultureKeyValue
+	(ResourceFormats)(0),              // 0: i18n.ResourceFormats
+	(ImportIssueTypes)(0),             // 1: i18n.ImportIssueTypes
+	(ActionTypes)(0),                  // 2: i18n.ActionTypes
+	(CultureStatuses)(0),              // 3: i18n.CultureStatuses
+	(TranslationStatuses)(0),          // 4: i18n.TranslationStatuses
+	(ResourceEventTypes)(0),           // 5: i18n.ResourceEventTypes
+	(ReplyCode)(0),                    // 6: i18n.ReplyCode
+	(*CultureCodeRequest)(nil),        // 7: i18n.CultureCodeRequest
+	(*CultureResourcesReply)(nil),     // 8: i18n.CultureResourcesReply
+	(*CultureCodesRequest)(nil),       // 9: i18n.CultureCodesRequest
+	(*CulturesResourcesReply)(nil),    // 10: i18n.CulturesResourcesReply
+	(*CultureResourceItem)(nil),       // 11: i18n.CultureResourceItem
+	(*TranslateRequest)(nil),          // 12: i18n.TranslateRequest
+	(*MessageArgument)(nil),           // 13: i18n.MessageArgument
+	(*TranslateReply)(nil),            // 14: i18n.TranslateReply
+	(*WatchCultureRequest)(nil),       // 15: i18n.WatchCultureRequest
+	(*CultureResourceEvent)(nil),      // 16: i18n.CultureResourceEvent
+	(*CacheStatsRequest)(nil),         // 17: i18n.CacheStatsRequest
+	(*CacheStatsReply)(nil),           // 18: i18n.CacheStatsReply
+	(*CultureBaseReply)(nil),          // 19: i18n.CultureBaseReply
+	(*CulturesRequest)(nil),           // 20: i18n.CulturesRequest
+	(*CulturesReply)(nil),             // 21: i18n.CulturesReply
+	(*CultureItem)(nil),               // 22: i18n.CultureItem
+	(*CultureProjectsRequest)(nil),    // 23: i18n.CultureProjectsRequest
+	(*CultureProjectsReply)(nil),      // 24: i18n.CultureProjectsReply
+	(*CultureProjectItem)(nil),        // 25: i18n.CultureProjectItem
+	(*CultureTypesRequest)(nil),       // 26: i18n.CultureTypesRequest
+	(*CulturesTypesReply)(nil),        // 27: i18n.CulturesTypesReply
+	(*CultureTypeItem)(nil),           // 28: i18n.CultureTypeItem
+	(*CultureKeysRequest)(nil),        // 29: i18n.CultureKeysRequest
+	(*CultureKeysReply)(nil),          // 30: i18n.CultureKeysReply
+	(*CultureKeyItem)(nil),            // 31: i18n.CultureKeyItem
+	(*CultureTagsRequest)(nil),        // 32: i18n.CultureTagsRequest
+	(*CultureTagsReply)(nil),          // 33: i18n.CultureTagsReply
+	(*CultureTagItem)(nil),            // 34: i18n.CultureTagItem
+	(*TagResourceKeysRequest)(nil),    // 35: i18n.TagResourceKeysRequest
+	(*CultureKeyValuesRequest)(nil),   // 36: i18n.CultureKeyValuesRequest
+	(*CultureKeyValuesReply)(nil),     // 37: i18n.CultureKeyValuesReply
+	(*CultureKeyValueItem)(nil),       // 38: i18n.CultureKeyValueItem
+	(*AddCultureKeyValueRequest)(nil), // 39: i18n.AddCultureKeyValueRequest
+	(*CultureKeyValue)(nil),           // 40: i18n.CultureKeyValue
+	(*TransitionLangsRequest)(nil),    // 41: i18n.TransitionLangsRequest
+	(*ResourceHistoryRequest)(nil),    // 42: i18n.ResourceHistoryRequest
+	(*ResourceHistoryReply)(nil),      // 43: i18n.ResourceHistoryReply
+	(*ResourceHistoryItem)(nil),       // 44: i18n.ResourceHistoryItem
+	(*ResourceTrashRequest)(nil),      // 45: i18n.ResourceTrashRequest
+	(*ResourceTrashReply)(nil),        // 46: i18n.ResourceTrashReply
+	(*ResourceTrashItem)(nil),         // 47: i18n.ResourceTrashItem
+	(*RestoreResourceRequest)(nil),    // 48: i18n.RestoreResourceRequest
+	(*RestoreRevisionRequest)(nil),    // 49: i18n.RestoreRevisionRequest
+	(*LintPlaceholdersRequest)(nil),   // 50: i18n.LintPlaceholdersRequest
+	(*LintPlaceholdersReply)(nil),     // 51: i18n.LintPlaceholdersReply
+	(*PlaceholderIssue)(nil),          // 52: i18n.PlaceholderIssue
+	(*ExportResourcesRequest)(nil),    // 53: i18n.ExportResourcesRequest
+	(*ExportResourcesReply)(nil),      // 54: i18n.ExportResourcesReply
+	(*ImportResourcesRequest)(nil),    // 55: i18n.ImportResourcesRequest
+	(*ImportResourcesReply)(nil),      // 56: i18n.ImportResourcesReply
+	(*ImportIssue)(nil),               // 57: i18n.ImportIssue
+	nil,                               // 58: i18n.CultureCodesRequest.IfNoneMatchEntry
+	nil,                               // 59: i18n.CulturesResourcesReply.CulturesEntry
+	nil,                               // 60: i18n.CultureResourceItem.PluralsEntry
+	nil,                               // 61: i18n.TranslateRequest.ArgumentsEntry
+	nil,                               // 62: i18n.TranslateReply.ErrorsEntry
+	nil,                               // 63: i18n.CultureKeyValue.PluralsEntry
+	(*structpb.Struct)(nil),           // 64: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),     // 65: google.protobuf.Timestamp
 }
 var file_i18n_proto_depIdxs = []int32{
-	4,  // 0: i18n.CultureResourcesReply.items:type_name -> i18n.CultureResourceItem
-	1,  // 1: i18n.CultureResourcesReply.code:type_name -> i18n.ReplyCode
-	1,  // 2: i18n.CultureBaseReply.code:type_name -> i18n.ReplyCode
-	0,  // 3: i18n.CulturesRequest.action:type_name -> i18n.ActionTypes
-	8,  // 4: i18n.CulturesRequest.param_data:type_name -> i18n.CultureItem
-	8,  // 5: i18n.CulturesReply.items:type_name -> i18n.CultureItem
-	1,  // 6: i18n.CulturesReply.code:type_name -> i18n.ReplyCode
-	0,  // 7: i18n.CultureTypesRequest.action:type_name -> i18n.ActionTypes
-	11, // 8: i18n.CultureTypesRequest.param_data:type_name -> i18n.CultureTypeItem
-	11, // 9: i18n.CulturesTypesReply.items:type_name -> i18n.CultureTypeItem
-	1,  // 10: i18n.CulturesTypesReply.code:type_name -> i18n.ReplyCode
-	0,  // 11: i18n.CultureKeysRequest.action:type_name -> i18n.ActionTypes
-	14, // 12: i18n.CultureKeysRequest.param_data:type_name -> i18n.CultureKeyItem
-	14, // 13: i18n.CultureKeysReply.items:type_name -> i18n.CultureKeyItem
-	1,  // 14: i18n.CultureKeysReply.code:type_name -> i18n.ReplyCode
-	0,  // 15: i18n.CultureKeyValuesRequest.action:type_name -> i18n.ActionTypes
-	17, // 16: i18n.CultureKeyValuesRequest.param_data:type_name -> i18n.CultureKeyValueItem
-	17, // 17: i18n.CultureKeyValuesReply.items:type_name -> i18n.CultureKeyValueItem
-	1,  // 18: i18n.CultureKeyValuesReply.code:type_name -> i18n.ReplyCode
-	19, // 19: i18n.AddCultureKeyValueRequest.values:type_name -> i18n.CultureKeyValue
-	6,  // 20: i18n.I18nService.CultureFeature:input_type -> i18n.CulturesRequest
-	9,  // 21: i18n.I18nService.CulturesResourceTypeFeature:input_type -> i18n.CultureTypesRequest
-	12, // 22: i18n.I18nService.CulturesResourceKeyFeature:input_type -> i18n.CultureKeysRequest
-	15, // 23: i18n.I18nService.CulturesResourceKeyValueFeature:input_type -> i18n.CultureKeyValuesRequest
-	18, // 24: i18n.I18nService.AddResourceKeyValue:input_type -> i18n.AddCultureKeyValueRequest
-	2,  // 25: i18n.I18nService.GetCultureResources:input_type -> i18n.CultureCodeRequest
-	7,  // 26: i18n.I18nService.CultureFeature:output_type -> i18n.CulturesReply
-	10, // 27: i18n.I18nService.CulturesResourceTypeFeature:output_type -> i18n.CulturesTypesReply
-	13, // 28: i18n.I18nService.CulturesResourceKeyFeature:output_type -> i18n.CultureKeysReply
-	16, // 29: i18n.I18nService.CulturesResourceKeyValueFeature:output_type -> i18n.CultureKeyValuesReply
-	5,  // 30: i18n.I18nService.AddResourceKeyValue:output_type -> i18n.CultureBaseReply
-	3,  // 31: i18n.I18nService.GetCultureResources:output_type -> i18n.CultureResourcesReply
-	26, // [26:32] is the sub-list for method output_type
-	20, // [20:26] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	11, // 0: i18n.CultureResourcesReply.items:type_name -> i18n.CultureResourceItem
+	6,  // 1: i18n.CultureResourcesReply.code:type_name -> i18n.ReplyCode
+	64, // 2: i18n.CultureResourcesReply.tree:type_name -> google.protobuf.Struct
+	58, // 3: i18n.CultureCodesRequest.if_none_match:type_name -> i18n.CultureCodesRequest.IfNoneMatchEntry
+	59, // 4: i18n.CulturesResourcesReply.cultures:type_name -> i18n.CulturesResourcesReply.CulturesEntry
+	6,  // 5: i18n.CulturesResourcesReply.code:type_name -> i18n.ReplyCode
+	60, // 6: i18n.CultureResourceItem.plurals:type_name -> i18n.CultureResourceItem.PluralsEntry
+	61, // 7: i18n.TranslateRequest.arguments:type_name -> i18n.TranslateRequest.ArgumentsEntry
+	65, // 8: i18n.MessageArgument.date_value:type_name -> google.protobuf.Timestamp
+	11, // 9: i18n.TranslateReply.items:type_name -> i18n.CultureResourceItem
+	6,  // 10: i18n.TranslateReply.code:type_name -> i18n.ReplyCode
+	62, // 11: i18n.TranslateReply.errors:type_name -> i18n.TranslateReply.ErrorsEntry
+	5,  // 12: i18n.CultureResourceEvent.type:type_name -> i18n.ResourceEventTypes
+	11, // 13: i18n.CultureResourceEvent.items:type_name -> i18n.CultureResourceItem
+	6,  // 14: i18n.CultureResourceEvent.code:type_name -> i18n.ReplyCode
+	6,  // 15: i18n.CacheStatsReply.code:type_name -> i18n.ReplyCode
+	6,  // 16: i18n.CultureBaseReply.code:type_name -> i18n.ReplyCode
+	2,  // 17: i18n.CulturesRequest.action:type_name -> i18n.ActionTypes
+	22, // 18: i18n.CulturesRequest.param_data:type_name -> i18n.CultureItem
+	22, // 19: i18n.CulturesReply.items:type_name -> i18n.CultureItem
+	6,  // 20: i18n.CulturesReply.code:type_name -> i18n.ReplyCode
+	3,  // 21: i18n.CultureItem.status:type_name -> i18n.CultureStatuses
+	2,  // 22: i18n.CultureProjectsRequest.action:type_name -> i18n.ActionTypes
+	25, // 23: i18n.CultureProjectsRequest.param_data:type_name -> i18n.CultureProjectItem
+	25, // 24: i18n.CultureProjectsReply.items:type_name -> i18n.CultureProjectItem
+	6,  // 25: i18n.CultureProjectsReply.code:type_name -> i18n.ReplyCode
+	2,  // 26: i18n.CultureTypesRequest.action:type_name -> i18n.ActionTypes
+	28, // 27: i18n.CultureTypesRequest.param_data:type_name -> i18n.CultureTypeItem
+	28, // 28: i18n.CulturesTypesReply.items:type_name -> i18n.CultureTypeItem
+	6,  // 29: i18n.CulturesTypesReply.code:type_name -> i18n.ReplyCode
+	2,  // 30: i18n.CultureKeysRequest.action:type_name -> i18n.ActionTypes
+	31, // 31: i18n.CultureKeysRequest.param_data:type_name -> i18n.CultureKeyItem
+	31, // 32: i18n.CultureKeysReply.items:type_name -> i18n.CultureKeyItem
+	6,  // 33: i18n.CultureKeysReply.code:type_name -> i18n.ReplyCode
+	2,  // 34: i18n.CultureTagsRequest.action:type_name -> i18n.ActionTypes
+	34, // 35: i18n.CultureTagsRequest.param_data:type_name -> i18n.CultureTagItem
+	34, // 36: i18n.CultureTagsReply.items:type_name -> i18n.CultureTagItem
+	6,  // 37: i18n.CultureTagsReply.code:type_name -> i18n.ReplyCode
+	2,  // 38: i18n.CultureKeyValuesRequest.action:type_name -> i18n.ActionTypes
+	38, // 39: i18n.CultureKeyValuesRequest.param_data:type_name -> i18n.CultureKeyValueItem
+	4,  // 40: i18n.CultureKeyValuesRequest.statuses:type_name -> i18n.TranslationStatuses
+	38, // 41: i18n.CultureKeyValuesReply.items:type_name -> i18n.CultureKeyValueItem
+	6,  // 42: i18n.CultureKeyValuesReply.code:type_name -> i18n.ReplyCode
+	4,  // 43: i18n.CultureKeyValueItem.status:type_name -> i18n.TranslationStatuses
+	65, // 44: i18n.CultureKeyValueItem.reviewed_at:type_name -> google.protobuf.Timestamp
+	65, // 45: i18n.CultureKeyValueItem.created_at:type_name -> google.protobuf.Timestamp
+	65, // 46: i18n.CultureKeyValueItem.updated_at:type_name -> google.protobuf.Timestamp
+	40, // 47: i18n.AddCultureKeyValueRequest.values:type_name -> i18n.CultureKeyValue
+	63, // 48: i18n.CultureKeyValue.plurals:type_name -> i18n.CultureKeyValue.PluralsEntry
+	4,  // 49: i18n.CultureKeyValue.status:type_name -> i18n.TranslationStatuses
+	4,  // 50: i18n.TransitionLangsRequest.status:type_name -> i18n.TranslationStatuses
+	44, // 51: i18n.ResourceHistoryReply.items:type_name -> i18n.ResourceHistoryItem
+	6,  // 52: i18n.ResourceHistoryReply.code:type_name -> i18n.ReplyCode
+	65, // 53: i18n.ResourceHistoryItem.created_at:type_name -> google.protobuf.Timestamp
+	47, // 54: i18n.ResourceTrashReply.items:type_name -> i18n.ResourceTrashItem
+	6,  // 55: i18n.ResourceTrashReply.code:type_name -> i18n.ReplyCode
+	65, // 56: i18n.ResourceTrashItem.deleted_at:type_name -> google.protobuf.Timestamp
+	65, // 57: i18n.ResourceTrashItem.purge_at:type_name -> google.protobuf.Timestamp
+	52, // 58: i18n.LintPlaceholdersReply.items:type_name -> i18n.PlaceholderIssue
+	6,  // 59: i18n.LintPlaceholdersReply.code:type_name -> i18n.ReplyCode
+	0,  // 60: i18n.ExportResourcesRequest.format:type_name -> i18n.ResourceFormats
+	6,  // 61: i18n.ExportResourcesReply.code:type_name -> i18n.ReplyCode
+	0,  // 62: i18n.ImportResourcesRequest.format:type_name -> i18n.ResourceFormats
+	4,  // 63: i18n.ImportResourcesRequest.status:type_name -> i18n.TranslationStatuses
+	6,  // 64: i18n.ImportResourcesReply.code:type_name -> i18n.ReplyCode
+	57, // 65: i18n.ImportResourcesReply.issues:type_name -> i18n.ImportIssue
+	1,  // 66: i18n.ImportIssue.type:type_name -> i18n.ImportIssueTypes
+	8,  // 67: i18n.CulturesResourcesReply.CulturesEntry.value:type_name -> i18n.CultureResourcesReply
+	13, // 68: i18n.TranslateRequest.ArgumentsEntry.value:type_name -> i18n.MessageArgument
+	20, // 69: i18n.I18nService.CultureFeature:input_type -> i18n.CulturesRequest
+	23, // 70: i18n.I18nService.CulturesProjectFeature:input_type -> i18n.CultureProjectsRequest
+	26, // 71: i18n.I18nService.CulturesResourceTypeFeature:input_type -> i18n.CultureTypesRequest
+	29, // 72: i18n.I18nService.CulturesResourceKeyFeature:input_type -> i18n.CultureKeysRequest
+	32, // 73: i18n.I18nService.CulturesResourceTagFeature:input_type -> i18n.CultureTagsRequest
+	35, // 74: i18n.I18nService.TagResourceKeys:input_type -> i18n.TagResourceKeysRequest
+	36, // 75: i18n.I18nService.CulturesResourceKeyValueFeature:input_type -> i18n.CultureKeyValuesRequest
+	39, // 76: i18n.I18nService.AddResourceKeyValue:input_type -> i18n.AddCultureKeyValueRequest
+	41, // 77: i18n.I18nService.TransitionResourceLangs:input_type -> i18n.TransitionLangsRequest
+	42, // 78: i18n.I18nService.GetResourceHistory:input_type -> i18n.ResourceHistoryRequest
+	49, // 79: i18n.I18nService.RestoreResourceRevision:input_type -> i18n.RestoreRevisionRequest
+	45, // 80: i18n.I18nService.GetResourceTrash:input_type -> i18n.ResourceTrashRequest
+	48, // 81: i18n.I18nService.RestoreResource:input_type -> i18n.RestoreResourceRequest
+	7,  // 82: i18n.I18nService.GetCultureResources:input_type -> i18n.CultureCodeRequest
+	9,  // 83: i18n.I18nService.GetCulturesResources:input_type -> i18n.CultureCodesRequest
+	17, // 84: i18n.I18nService.GetResourceCacheStats:input_type -> i18n.CacheStatsRequest
+	15, // 85: i18n.I18nService.WatchCultureResources:input_type -> i18n.WatchCultureRequest
+	12, // 86: i18n.I18nService.Translate:input_type -> i18n.TranslateRequest
+	50, // 87: i18n.I18nService.LintResourcePlaceholders:input_type -> i18n.LintPlaceholdersRequest
+	53, // 88: i18n.I18nService.ExportResources:input_type -> i18n.ExportResourcesRequest
+	55, // 89: i18n.I18nService.ImportResources:input_type -> i18n.ImportResourcesRequest
+	21, // 90: i18n.I18nService.CultureFeature:output_type -> i18n.CulturesReply
+	24, // 91: i18n.I18nService.CulturesProjectFeature:output_type -> i18n.CultureProjectsReply
+	27, // 92: i18n.I18nService.CulturesResourceTypeFeature:output_type -> i18n.CulturesTypesReply
+	30, // 93: i18n.I18nService.CulturesResourceKeyFeature:output_type -> i18n.CultureKeysReply
+	33, // 94: i18n.I18nService.CulturesResourceTagFeature:output_type -> i18n.CultureTagsReply
+	19, // 95: i18n.I18nService.TagResourceKeys:output_type -> i18n.CultureBaseReply
+	37, // 96: i18n.I18nService.CulturesResourceKeyValueFeature:output_type -> i18n.CultureKeyValuesReply
+	19, // 97: i18n.I18nService.AddResourceKeyValue:output_type -> i18n.CultureBaseReply
+	19, // 98: i18n.I18nService.TransitionResourceLangs:output_type -> i18n.CultureBaseReply
+	43, // 99: i18n.I18nService.GetResourceHistory:output_type -> i18n.ResourceHistoryReply
+	19, // 100: i18n.I18nService.RestoreResourceRevision:output_type -> i18n.CultureBaseReply
+	46, // 101: i18n.I18nService.GetResourceTrash:output_type -> i18n.ResourceTrashReply
+	19, // 102: i18n.I18nService.RestoreResource:output_type -> i18n.CultureBaseReply
+	8,  // 103: i18n.I18nService.GetCultureResources:output_type -> i18n.CultureResourcesReply
+	10, // 104: i18n.I18nService.GetCulturesResources:output_type -> i18n.CulturesResourcesReply
+	18, // 105: i18n.I18nService.GetResourceCacheStats:output_type -> i18n.CacheStatsReply
+	16, // 106: i18n.I18nService.WatchCultureResources:output_type -> i18n.CultureResourceEvent
+	14, // 107: i18n.I18nService.Translate:output_type -> i18n.TranslateReply
+	51, // 108: i18n.I18nService.LintResourcePlaceholders:output_type -> i18n.LintPlaceholdersReply
+	54, // 109: i18n.I18nService.ExportResources:output_type -> i18n.ExportResourcesReply
+	56, // 110: i18n.I18nService.ImportResources:output_type -> i18n.ImportResourcesReply
+	90, // [90:111] is the sub-list for method output_type
+	69, // [69:90] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_i18n_proto_init() }
@@ -1624,8 +5493,140 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*CultureResourcesReply); i {
+		file_i18n_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureResourcesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureCodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CulturesResourcesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureResourceItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*TranslateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*MessageArgument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*TranslateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchCultureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureResourceEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*CacheStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*CacheStatsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureBaseReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1636,8 +5637,8 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*CultureResourceItem); i {
+		file_i18n_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*CulturesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1648,8 +5649,8 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*CultureBaseReply); i {
+		file_i18n_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*CulturesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1660,8 +5661,8 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*CulturesRequest); i {
+		file_i18n_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1672,8 +5673,8 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*CulturesReply); i {
+		file_i18n_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureProjectsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1684,8 +5685,8 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*CultureItem); i {
+		file_i18n_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureProjectsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1696,7 +5697,19 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[7].Exporter = func(v any, i int) any {
+		file_i18n_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureProjectItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[19].Exporter = func(v any, i int) any {
 			switch v := v.(*CultureTypesRequest); i {
 			case 0:
 				return &v.state
@@ -1708,7 +5721,7 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_i18n_proto_msgTypes[20].Exporter = func(v any, i int) any {
 			switch v := v.(*CulturesTypesReply); i {
 			case 0:
 				return &v.state
@@ -1720,7 +5733,7 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[9].Exporter = func(v any, i int) any {
+		file_i18n_proto_msgTypes[21].Exporter = func(v any, i int) any {
 			switch v := v.(*CultureTypeItem); i {
 			case 0:
 				return &v.state
@@ -1732,7 +5745,7 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[10].Exporter = func(v any, i int) any {
+		file_i18n_proto_msgTypes[22].Exporter = func(v any, i int) any {
 			switch v := v.(*CultureKeysRequest); i {
 			case 0:
 				return &v.state
@@ -1744,7 +5757,7 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_i18n_proto_msgTypes[23].Exporter = func(v any, i int) any {
 			switch v := v.(*CultureKeysReply); i {
 			case 0:
 				return &v.state
@@ -1756,7 +5769,7 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_i18n_proto_msgTypes[24].Exporter = func(v any, i int) any {
 			switch v := v.(*CultureKeyItem); i {
 			case 0:
 				return &v.state
@@ -1768,7 +5781,55 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_i18n_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureTagsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*CultureTagItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*TagResourceKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[29].Exporter = func(v any, i int) any {
 			switch v := v.(*CultureKeyValuesRequest); i {
 			case 0:
 				return &v.state
@@ -1780,7 +5841,7 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[14].Exporter = func(v any, i int) any {
+		file_i18n_proto_msgTypes[30].Exporter = func(v any, i int) any {
 			switch v := v.(*CultureKeyValuesReply); i {
 			case 0:
 				return &v.state
@@ -1792,7 +5853,7 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[15].Exporter = func(v any, i int) any {
+		file_i18n_proto_msgTypes[31].Exporter = func(v any, i int) any {
 			switch v := v.(*CultureKeyValueItem); i {
 			case 0:
 				return &v.state
@@ -1804,7 +5865,7 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[16].Exporter = func(v any, i int) any {
+		file_i18n_proto_msgTypes[32].Exporter = func(v any, i int) any {
 			switch v := v.(*AddCultureKeyValueRequest); i {
 			case 0:
 				return &v.state
@@ -1816,7 +5877,7 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
-		file_i18n_proto_msgTypes[17].Exporter = func(v any, i int) any {
+		file_i18n_proto_msgTypes[33].Exporter = func(v any, i int) any {
 			switch v := v.(*CultureKeyValue); i {
 			case 0:
 				return &v.state
@@ -1828,14 +5889,224 @@ func file_i18n_proto_init() {
 				return nil
 			}
 		}
+		file_i18n_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*TransitionLangsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ResourceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ResourceHistoryReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ResourceHistoryItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ResourceTrashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*ResourceTrashReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*ResourceTrashItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*LintPlaceholdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*LintPlaceholdersReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*PlaceholderIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportResourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportResourcesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportResourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportResourcesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_i18n_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_i18n_proto_msgTypes[6].OneofWrappers = []any{
+		(*MessageArgument_StringValue)(nil),
+		(*MessageArgument_IntValue)(nil),
+		(*MessageArgument_FloatValue)(nil),
+		(*MessageArgument_DateValue)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_i18n_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   18,
+			NumEnums:      7,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,11 +20,26 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	I18NService_CultureFeature_FullMethodName                  = "/i18n.I18nService/CultureFeature"
+	I18NService_CulturesProjectFeature_FullMethodName          = "/i18n.I18nService/CulturesProjectFeature"
 	I18NService_CulturesResourceTypeFeature_FullMethodName     = "/i18n.I18nService/CulturesResourceTypeFeature"
 	I18NService_CulturesResourceKeyFeature_FullMethodName      = "/i18n.I18nService/CulturesResourceKeyFeature"
+	I18NService_CulturesResourceTagFeature_FullMethodName      = "/i18n.I18nService/CulturesResourceTagFeature"
+	I18NService_TagResourceKeys_FullMethodName                 = "/i18n.I18nService/TagResourceKeys"
 	I18NService_CulturesResourceKeyValueFeature_FullMethodName = "/i18n.I18nService/CulturesResourceKeyValueFeature"
 	I18NService_AddResourceKeyValue_FullMethodName             = "/i18n.I18nService/AddResourceKeyValue"
+	I18NService_TransitionResourceLangs_FullMethodName         = "/i18n.I18nService/TransitionResourceLangs"
+	I18NService_GetResourceHistory_FullMethodName              = "/i18n.I18nService/GetResourceHistory"
+	I18NService_RestoreResourceRevision_FullMethodName         = "/i18n.I18nService/RestoreResourceRevision"
+	I18NService_GetResourceTrash_FullMethodName                = "/i18n.I18nService/GetResourceTrash"
+	I18NService_RestoreResource_FullMethodName                 = "/i18n.I18nService/RestoreResource"
 	I18NService_GetCultureResources_FullMethodName             = "/i18n.I18nService/GetCultureResources"
+	I18NService_GetCulturesResources_FullMethodName            = "/i18n.I18nService/GetCulturesResources"
+	I18NService_GetResourceCacheStats_FullMethodName           = "/i18n.I18nService/GetResourceCacheStats"
+	I18NService_WatchCultureResources_FullMethodName           = "/i18n.I18nService/WatchCultureResources"
+	I18NService_Translate_FullMethodName                       = "/i18n.I18nService/Translate"
+	I18NService_LintResourcePlaceholders_FullMethodName        = "/i18n.I18nService/LintResourcePlaceholders"
+	I18NService_ExportResources_FullMethodName                 = "/i18n.I18nService/ExportResources"
+	I18NService_ImportResources_FullMethodName                 = "/i18n.I18nService/ImportResources"
 )
 
 // I18NServiceClient is the client API for I18NService service.
@@ -33,16 +48,46 @@ const (
 type I18NServiceClient interface {
 	// 国家语言功能
 	CultureFeature(ctx context.Context, in *CulturesRequest, opts ...grpc.CallOption) (*CulturesReply, error)
+	// 项目功能，项目隔离资源类型、资源key和启用的语言
+	CulturesProjectFeature(ctx context.Context, in *CultureProjectsRequest, opts ...grpc.CallOption) (*CultureProjectsReply, error)
 	// 语言资源类型功能
 	CulturesResourceTypeFeature(ctx context.Context, in *CultureTypesRequest, opts ...grpc.CallOption) (*CulturesTypesReply, error)
 	// 语言资源key功能
 	CulturesResourceKeyFeature(ctx context.Context, in *CultureKeysRequest, opts ...grpc.CallOption) (*CultureKeysReply, error)
+	// 语言资源标签功能
+	CulturesResourceTagFeature(ctx context.Context, in *CultureTagsRequest, opts ...grpc.CallOption) (*CultureTagsReply, error)
+	// 为语言资源key添加或移除标签
+	TagResourceKeys(ctx context.Context, in *TagResourceKeysRequest, opts ...grpc.CallOption) (*CultureBaseReply, error)
 	// 语言资源key值功能
 	CulturesResourceKeyValueFeature(ctx context.Context, in *CultureKeyValuesRequest, opts ...grpc.CallOption) (*CultureKeyValuesReply, error)
 	// 添加资源key和多个语言翻译
 	AddResourceKeyValue(ctx context.Context, in *AddCultureKeyValueRequest, opts ...grpc.CallOption) (*CultureBaseReply, error)
+	// 流转语言翻译的审核状态
+	TransitionResourceLangs(ctx context.Context, in *TransitionLangsRequest, opts ...grpc.CallOption) (*CultureBaseReply, error)
+	// 获取资源key或资源类型的修改历史
+	GetResourceHistory(ctx context.Context, in *ResourceHistoryRequest, opts ...grpc.CallOption) (*ResourceHistoryReply, error)
+	// 还原到指定的历史版本
+	RestoreResourceRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*CultureBaseReply, error)
+	// 获取回收站中的资源key或资源类型
+	GetResourceTrash(ctx context.Context, in *ResourceTrashRequest, opts ...grpc.CallOption) (*ResourceTrashReply, error)
+	// 从回收站还原资源key或资源类型
+	RestoreResource(ctx context.Context, in *RestoreResourceRequest, opts ...grpc.CallOption) (*CultureBaseReply, error)
 	// 根据语言代码获取翻译资源
 	GetCultureResources(ctx context.Context, in *CultureCodeRequest, opts ...grpc.CallOption) (*CultureResourcesReply, error)
+	// 根据多个语言代码一次获取翻译资源
+	GetCulturesResources(ctx context.Context, in *CultureCodesRequest, opts ...grpc.CallOption) (*CulturesResourcesReply, error)
+	// 获取翻译资源缓存统计
+	GetResourceCacheStats(ctx context.Context, in *CacheStatsRequest, opts ...grpc.CallOption) (*CacheStatsReply, error)
+	// 订阅语言翻译资源变更，先推送全量快照，再推送本实例写入的增量变更
+	WatchCultureResources(ctx context.Context, in *WatchCultureRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CultureResourceEvent], error)
+	// 批量翻译指定的资源key
+	Translate(ctx context.Context, in *TranslateRequest, opts ...grpc.CallOption) (*TranslateReply, error)
+	// 检查各语言翻译与默认语言翻译的占位符是否一致
+	LintResourcePlaceholders(ctx context.Context, in *LintPlaceholdersRequest, opts ...grpc.CallOption) (*LintPlaceholdersReply, error)
+	// 将语言的资源导出为本地化文件
+	ExportResources(ctx context.Context, in *ExportResourcesRequest, opts ...grpc.CallOption) (*ExportResourcesReply, error)
+	// 导入本地化文件中的资源key和翻译
+	ImportResources(ctx context.Context, in *ImportResourcesRequest, opts ...grpc.CallOption) (*ImportResourcesReply, error)
 }
 
 type i18NServiceClient struct {
@@ -63,6 +108,16 @@ func (c *i18NServiceClient) CultureFeature(ctx context.Context, in *CulturesRequ
 	return out, nil
 }
 
+func (c *i18NServiceClient) CulturesProjectFeature(ctx context.Context, in *CultureProjectsRequest, opts ...grpc.CallOption) (*CultureProjectsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CultureProjectsReply)
+	err := c.cc.Invoke(ctx, I18NService_CulturesProjectFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *i18NServiceClient) CulturesResourceTypeFeature(ctx context.Context, in *CultureTypesRequest, opts ...grpc.CallOption) (*CulturesTypesReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CulturesTypesReply)
@@ -83,6 +138,26 @@ func (c *i18NServiceClient) CulturesResourceKeyFeature(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *i18NServiceClient) CulturesResourceTagFeature(ctx context.Context, in *CultureTagsRequest, opts ...grpc.CallOption) (*CultureTagsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CultureTagsReply)
+	err := c.cc.Invoke(ctx, I18NService_CulturesResourceTagFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *i18NServiceClient) TagResourceKeys(ctx context.Context, in *TagResourceKeysRequest, opts ...grpc.CallOption) (*CultureBaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CultureBaseReply)
+	err := c.cc.Invoke(ctx, I18NService_TagResourceKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *i18NServiceClient) CulturesResourceKeyValueFeature(ctx context.Context, in *CultureKeyValuesRequest, opts ...grpc.CallOption) (*CultureKeyValuesReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CultureKeyValuesReply)
@@ -103,6 +178,56 @@ func (c *i18NServiceClient) AddResourceKeyValue(ctx context.Context, in *AddCult
 	return out, nil
 }
 
+func (c *i18NServiceClient) TransitionResourceLangs(ctx context.Context, in *TransitionLangsRequest, opts ...grpc.CallOption) (*CultureBaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CultureBaseReply)
+	err := c.cc.Invoke(ctx, I18NService_TransitionResourceLangs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *i18NServiceClient) GetResourceHistory(ctx context.Context, in *ResourceHistoryRequest, opts ...grpc.CallOption) (*ResourceHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceHistoryReply)
+	err := c.cc.Invoke(ctx, I18NService_GetResourceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *i18NServiceClient) RestoreResourceRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*CultureBaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CultureBaseReply)
+	err := c.cc.Invoke(ctx, I18NService_RestoreResourceRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *i18NServiceClient) GetResourceTrash(ctx context.Context, in *ResourceTrashRequest, opts ...grpc.CallOption) (*ResourceTrashReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceTrashReply)
+	err := c.cc.Invoke(ctx, I18NService_GetResourceTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *i18NServiceClient) RestoreResource(ctx context.Context, in *RestoreResourceRequest, opts ...grpc.CallOption) (*CultureBaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CultureBaseReply)
+	err := c.cc.Invoke(ctx, I18NService_RestoreResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *i18NServiceClient) GetCultureResources(ctx context.Context, in *CultureCodeRequest, opts ...grpc.CallOption) (*CultureResourcesReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CultureResourcesReply)
@@ -113,22 +238,131 @@ func (c *i18NServiceClient) GetCultureResources(ctx context.Context, in *Culture
 	return out, nil
 }
 
+func (c *i18NServiceClient) GetCulturesResources(ctx context.Context, in *CultureCodesRequest, opts ...grpc.CallOption) (*CulturesResourcesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CulturesResourcesReply)
+	err := c.cc.Invoke(ctx, I18NService_GetCulturesResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *i18NServiceClient) GetResourceCacheStats(ctx context.Context, in *CacheStatsRequest, opts ...grpc.CallOption) (*CacheStatsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CacheStatsReply)
+	err := c.cc.Invoke(ctx, I18NService_GetResourceCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *i18NServiceClient) WatchCultureResources(ctx context.Context, in *WatchCultureRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CultureResourceEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &I18NService_ServiceDesc.Streams[0], I18NService_WatchCultureResources_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchCultureRequest, CultureResourceEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type I18NService_WatchCultureResourcesClient = grpc.ServerStreamingClient[CultureResourceEvent]
+
+func (c *i18NServiceClient) Translate(ctx context.Context, in *TranslateRequest, opts ...grpc.CallOption) (*TranslateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TranslateReply)
+	err := c.cc.Invoke(ctx, I18NService_Translate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *i18NServiceClient) LintResourcePlaceholders(ctx context.Context, in *LintPlaceholdersRequest, opts ...grpc.CallOption) (*LintPlaceholdersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LintPlaceholdersReply)
+	err := c.cc.Invoke(ctx, I18NService_LintResourcePlaceholders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *i18NServiceClient) ExportResources(ctx context.Context, in *ExportResourcesRequest, opts ...grpc.CallOption) (*ExportResourcesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportResourcesReply)
+	err := c.cc.Invoke(ctx, I18NService_ExportResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *i18NServiceClient) ImportResources(ctx context.Context, in *ImportResourcesRequest, opts ...grpc.CallOption) (*ImportResourcesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportResourcesReply)
+	err := c.cc.Invoke(ctx, I18NService_ImportResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // I18NServiceServer is the server API for I18NService service.
 // All implementations must embed UnimplementedI18NServiceServer
 // for forward compatibility.
 type I18NServiceServer interface {
 	// 国家语言功能
 	CultureFeature(context.Context, *CulturesRequest) (*CulturesReply, error)
+	// 项目功能，项目隔离资源类型、资源key和启用的语言
+	CulturesProjectFeature(context.Context, *CultureProjectsRequest) (*CultureProjectsReply, error)
 	// 语言资源类型功能
 	CulturesResourceTypeFeature(context.Context, *CultureTypesRequest) (*CulturesTypesReply, error)
 	// 语言资源key功能
 	CulturesResourceKeyFeature(context.Context, *CultureKeysRequest) (*CultureKeysReply, error)
+	// 语言资源标签功能
+	CulturesResourceTagFeature(context.Context, *CultureTagsRequest) (*CultureTagsReply, error)
+	// 为语言资源key添加或移除标签
+	TagResourceKeys(context.Context, *TagResourceKeysRequest) (*CultureBaseReply, error)
 	// 语言资源key值功能
 	CulturesResourceKeyValueFeature(context.Context, *CultureKeyValuesRequest) (*CultureKeyValuesReply, error)
 	// 添加资源key和多个语言翻译
 	AddResourceKeyValue(context.Context, *AddCultureKeyValueRequest) (*CultureBaseReply, error)
+	// 流转语言翻译的审核状态
+	TransitionResourceLangs(context.Context, *TransitionLangsRequest) (*CultureBaseReply, error)
+	// 获取资源key或资源类型的修改历史
+	GetResourceHistory(context.Context, *ResourceHistoryRequest) (*ResourceHistoryReply, error)
+	// 还原到指定的历史版本
+	RestoreResourceRevision(context.Context, *RestoreRevisionRequest) (*CultureBaseReply, error)
+	// 获取回收站中的资源key或资源类型
+	GetResourceTrash(context.Context, *ResourceTrashRequest) (*ResourceTrashReply, error)
+	// 从回收站还原资源key或资源类型
+	RestoreResource(context.Context, *RestoreResourceRequest) (*CultureBaseReply, error)
 	// 根据语言代码获取翻译资源
 	GetCultureResources(context.Context, *CultureCodeRequest) (*CultureResourcesReply, error)
+	// 根据多个语言代码一次获取翻译资源
+	GetCulturesResources(context.Context, *CultureCodesRequest) (*CulturesResourcesReply, error)
+	// 获取翻译资源缓存统计
+	GetResourceCacheStats(context.Context, *CacheStatsRequest) (*CacheStatsReply, error)
+	// 订阅语言翻译资源变更，先推送全量快照，再推送本实例写入的增量变更
+	WatchCultureResources(*WatchCultureRequest, grpc.ServerStreamingServer[CultureResourceEvent]) error
+	// 批量翻译指定的资源key
+	Translate(context.Context, *TranslateRequest) (*TranslateReply, error)
+	// 检查各语言翻译与默认语言翻译的占位符是否一致
+	LintResourcePlaceholders(context.Context, *LintPlaceholdersRequest) (*LintPlaceholdersReply, error)
+	// 将语言的资源导出为本地化文件
+	ExportResources(context.Context, *ExportResourcesRequest) (*ExportResourcesReply, error)
+	// 导入本地化文件中的资源key和翻译
+	ImportResources(context.Context, *ImportResourcesRequest) (*ImportResourcesReply, error)
 	mustEmbedUnimplementedI18NServiceServer()
 }
 
@@ -142,21 +376,66 @@ type UnimplementedI18NServiceServer struct{}
 func (UnimplementedI18NServiceServer) CultureFeature(context.Context, *CulturesRequest) (*CulturesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CultureFeature not implemented")
 }
+func (UnimplementedI18NServiceServer) CulturesProjectFeature(context.Context, *CultureProjectsRequest) (*CultureProjectsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CulturesProjectFeature not implemented")
+}
 func (UnimplementedI18NServiceServer) CulturesResourceTypeFeature(context.Context, *CultureTypesRequest) (*CulturesTypesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CulturesResourceTypeFeature not implemented")
 }
 func (UnimplementedI18NServiceServer) CulturesResourceKeyFeature(context.Context, *CultureKeysRequest) (*CultureKeysReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CulturesResourceKeyFeature not implemented")
 }
+func (UnimplementedI18NServiceServer) CulturesResourceTagFeature(context.Context, *CultureTagsRequest) (*CultureTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CulturesResourceTagFeature not implemented")
+}
+func (UnimplementedI18NServiceServer) TagResourceKeys(context.Context, *TagResourceKeysRequest) (*CultureBaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagResourceKeys not implemented")
+}
 func (UnimplementedI18NServiceServer) CulturesResourceKeyValueFeature(context.Context, *CultureKeyValuesRequest) (*CultureKeyValuesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CulturesResourceKeyValueFeature not implemented")
 }
 func (UnimplementedI18NServiceServer) AddResourceKeyValue(context.Context, *AddCultureKeyValueRequest) (*CultureBaseReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddResourceKeyValue not implemented")
 }
+func (UnimplementedI18NServiceServer) TransitionResourceLangs(context.Context, *TransitionLangsRequest) (*CultureBaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionResourceLangs not implemented")
+}
+func (UnimplementedI18NServiceServer) GetResourceHistory(context.Context, *ResourceHistoryRequest) (*ResourceHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceHistory not implemented")
+}
+func (UnimplementedI18NServiceServer) RestoreResourceRevision(context.Context, *RestoreRevisionRequest) (*CultureBaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreResourceRevision not implemented")
+}
+func (UnimplementedI18NServiceServer) GetResourceTrash(context.Context, *ResourceTrashRequest) (*ResourceTrashReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceTrash not implemented")
+}
+func (UnimplementedI18NServiceServer) RestoreResource(context.Context, *RestoreResourceRequest) (*CultureBaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreResource not implemented")
+}
 func (UnimplementedI18NServiceServer) GetCultureResources(context.Context, *CultureCodeRequest) (*CultureResourcesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCultureResources not implemented")
 }
+func (UnimplementedI18NServiceServer) GetCulturesResources(context.Context, *CultureCodesRequest) (*CulturesResourcesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCulturesResources not implemented")
+}
+func (UnimplementedI18NServiceServer) GetResourceCacheStats(context.Context, *CacheStatsRequest) (*CacheStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceCacheStats not implemented")
+}
+func (UnimplementedI18NServiceServer) WatchCultureResources(*WatchCultureRequest, grpc.ServerStreamingServer[CultureResourceEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCultureResources not implemented")
+}
+func (UnimplementedI18NServiceServer) Translate(context.Context, *TranslateRequest) (*TranslateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Translate not implemented")
+}
+func (UnimplementedI18NServiceServer) LintResourcePlaceholders(context.Context, *LintPlaceholdersRequest) (*LintPlaceholdersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LintResourcePlaceholders not implemented")
+}
+func (UnimplementedI18NServiceServer) ExportResources(context.Context, *ExportResourcesRequest) (*ExportResourcesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportResources not implemented")
+}
+func (UnimplementedI18NServiceServer) ImportResources(context.Context, *ImportResourcesRequest) (*ImportResourcesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportResources not implemented")
+}
 func (UnimplementedI18NServiceServer) mustEmbedUnimplementedI18NServiceServer() {}
 func (UnimplementedI18NServiceServer) testEmbeddedByValue()                     {}
 
@@ -196,6 +475,24 @@ func _I18NService_CultureFeature_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _I18NService_CulturesProjectFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CultureProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).CulturesProjectFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_CulturesProjectFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).CulturesProjectFeature(ctx, req.(*CultureProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _I18NService_CulturesResourceTypeFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CultureTypesRequest)
 	if err := dec(in); err != nil {
@@ -232,6 +529,42 @@ func _I18NService_CulturesResourceKeyFeature_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _I18NService_CulturesResourceTagFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CultureTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).CulturesResourceTagFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_CulturesResourceTagFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).CulturesResourceTagFeature(ctx, req.(*CultureTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _I18NService_TagResourceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagResourceKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).TagResourceKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_TagResourceKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).TagResourceKeys(ctx, req.(*TagResourceKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _I18NService_CulturesResourceKeyValueFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CultureKeyValuesRequest)
 	if err := dec(in); err != nil {
@@ -268,6 +601,96 @@ func _I18NService_AddResourceKeyValue_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _I18NService_TransitionResourceLangs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionLangsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).TransitionResourceLangs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_TransitionResourceLangs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).TransitionResourceLangs(ctx, req.(*TransitionLangsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _I18NService_GetResourceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).GetResourceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_GetResourceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).GetResourceHistory(ctx, req.(*ResourceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _I18NService_RestoreResourceRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).RestoreResourceRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_RestoreResourceRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).RestoreResourceRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _I18NService_GetResourceTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).GetResourceTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_GetResourceTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).GetResourceTrash(ctx, req.(*ResourceTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _I18NService_RestoreResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).RestoreResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_RestoreResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).RestoreResource(ctx, req.(*RestoreResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _I18NService_GetCultureResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CultureCodeRequest)
 	if err := dec(in); err != nil {
@@ -286,6 +709,125 @@ func _I18NService_GetCultureResources_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _I18NService_GetCulturesResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CultureCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).GetCulturesResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_GetCulturesResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).GetCulturesResources(ctx, req.(*CultureCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _I18NService_GetResourceCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).GetResourceCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_GetResourceCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).GetResourceCacheStats(ctx, req.(*CacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _I18NService_WatchCultureResources_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCultureRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(I18NServiceServer).WatchCultureResources(m, &grpc.GenericServerStream[WatchCultureRequest, CultureResourceEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type I18NService_WatchCultureResourcesServer = grpc.ServerStreamingServer[CultureResourceEvent]
+
+func _I18NService_Translate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).Translate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_Translate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).Translate(ctx, req.(*TranslateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _I18NService_LintResourcePlaceholders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LintPlaceholdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).LintResourcePlaceholders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_LintResourcePlaceholders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).LintResourcePlaceholders(ctx, req.(*LintPlaceholdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _I18NService_ExportResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).ExportResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_ExportResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).ExportResources(ctx, req.(*ExportResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _I18NService_ImportResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(I18NServiceServer).ImportResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: I18NService_ImportResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(I18NServiceServer).ImportResources(ctx, req.(*ImportResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // I18NService_ServiceDesc is the grpc.ServiceDesc for I18NService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -297,6 +839,10 @@ var I18NService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CultureFeature",
 			Handler:    _I18NService_CultureFeature_Handler,
 		},
+		{
+			MethodName: "CulturesProjectFeature",
+			Handler:    _I18NService_CulturesProjectFeature_Handler,
+		},
 		{
 			MethodName: "CulturesResourceTypeFeature",
 			Handler:    _I18NService_CulturesResourceTypeFeature_Handler,
@@ -305,6 +851,14 @@ var I18NService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CulturesResourceKeyFeature",
 			Handler:    _I18NService_CulturesResourceKeyFeature_Handler,
 		},
+		{
+			MethodName: "CulturesResourceTagFeature",
+			Handler:    _I18NService_CulturesResourceTagFeature_Handler,
+		},
+		{
+			MethodName: "TagResourceKeys",
+			Handler:    _I18NService_TagResourceKeys_Handler,
+		},
 		{
 			MethodName: "CulturesResourceKeyValueFeature",
 			Handler:    _I18NService_CulturesResourceKeyValueFeature_Handler,
@@ -313,11 +867,61 @@ var I18NService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddResourceKeyValue",
 			Handler:    _I18NService_AddResourceKeyValue_Handler,
 		},
+		{
+			MethodName: "TransitionResourceLangs",
+			Handler:    _I18NService_TransitionResourceLangs_Handler,
+		},
+		{
+			MethodName: "GetResourceHistory",
+			Handler:    _I18NService_GetResourceHistory_Handler,
+		},
+		{
+			MethodName: "RestoreResourceRevision",
+			Handler:    _I18NService_RestoreResourceRevision_Handler,
+		},
+		{
+			MethodName: "GetResourceTrash",
+			Handler:    _I18NService_GetResourceTrash_Handler,
+		},
+		{
+			MethodName: "RestoreResource",
+			Handler:    _I18NService_RestoreResource_Handler,
+		},
 		{
 			MethodName: "GetCultureResources",
 			Handler:    _I18NService_GetCultureResources_Handler,
 		},
+		{
+			MethodName: "GetCulturesResources",
+			Handler:    _I18NService_GetCulturesResources_Handler,
+		},
+		{
+			MethodName: "GetResourceCacheStats",
+			Handler:    _I18NService_GetResourceCacheStats_Handler,
+		},
+		{
+			MethodName: "Translate",
+			Handler:    _I18NService_Translate_Handler,
+		},
+		{
+			MethodName: "LintResourcePlaceholders",
+			Handler:    _I18NService_LintResourcePlaceholders_Handler,
+		},
+		{
+			MethodName: "ExportResources",
+			Handler:    _I18NService_ExportResources_Handler,
+		},
+		{
+			MethodName: "ImportResources",
+			Handler:    _I18NService_ImportResources_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCultureResources",
+			Handler:       _I18NService_WatchCultureResources_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "i18n.proto",
 }
@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"context"
+	"i18n-service/data/entity"
+	"i18n-service/data/repository"
+	"i18n-service/proto"
+	"sort"
+)
+
+// LintResourcePlaceholders 检查各语言的翻译与默认语言翻译的占位符是否一致。
+// 支持 ICU 参数 {name}、{{name}} 和 printf 形式的 %s，默认语言没有翻译的资源键不检查。
+// 复数翻译的每个复数形式与默认语言的同一复数类别比较，默认语言没有该类别时与其 other 形式比较，每个不一致的形式返回一项。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含需要检查的语言代码的请求对象，为空时检查默认语言以外的所有语言。
+//
+// 返回值:
+//
+//	*proto.LintPlaceholdersReply - 包含所有占位符不一致的翻译的响应对象。
+//	error - 错误对象。
+func (c *CulturesRpc) LintResourcePlaceholders(ctx context.Context, req *proto.LintPlaceholdersRequest) (*proto.LintPlaceholdersReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.LintPlaceholdersReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	cultures, err := repo.GetCultures()
+	if err != nil {
+		return &proto.LintPlaceholdersReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+	var reference *entity.CulturesResources
+	for i, v := range cultures {
+		if v.IsDefault {
+			reference = &cultures[i]
+			break
+		}
+	}
+	if reference == nil {
+		return &proto.LintPlaceholdersReply{Message: "default culture not exists", Code: proto.ReplyCode_NotFound}, nil
+	}
+	codes := req.Codes
+	if len(codes) == 0 {
+		for _, v := range cultures {
+			if v.ID != reference.ID {
+				codes = append(codes, v.Code)
+			}
+		}
+	}
+	keys, err := repo.GetCulturesResourceKeys()
+	if err != nil {
+		return &proto.LintPlaceholdersReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+	base, err := repo.GetCultureSnapshot(reference.Code)
+	if err != nil {
+		return &proto.LintPlaceholdersReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+	var items []*proto.PlaceholderIssue
+	for _, code := range codes {
+		snapshot, err := repo.GetCultureSnapshot(code)
+		if err != nil {
+			return &proto.LintPlaceholdersReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+		}
+		if snapshot.Culture.ID == reference.ID {
+			continue
+		}
+		for keyID, text := range snapshot.Texts {
+			name, ok := keys[keyID]
+			want, has := base.Texts[keyID]
+			if !ok || !has {
+				continue
+			}
+			for _, m := range repository.CompareFormPlaceholders(want, base.Plurals[keyID], text, snapshot.Plurals[keyID]) {
+				items = append(items, &proto.PlaceholderIssue{
+					KeyId:      keyID,
+					Key:        name,
+					Culture:    snapshot.Culture.Code,
+					Text:       m.Text,
+					Reference:  m.Reference,
+					Missing:    m.Missing,
+					Unexpected: m.Unexpected,
+					Category:   m.Category,
+				})
+			}
+		}
+	}
+	// 同一翻译的复数形式保持复数类别的顺序
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Key != items[j].Key {
+			return items[i].Key < items[j].Key
+		}
+		return items[i].Culture < items[j].Culture
+	})
+	return &proto.LintPlaceholdersReply{Items: items, Code: proto.ReplyCode_Success, Message: "ok"}, nil
+}
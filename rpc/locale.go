@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"i18n-service/data/entity"
+	"i18n-service/data/repository"
+
+	"i18n-service/proto"
+
+	"golang.org/x/text/language"
+)
+
+// ErrCultureDisabled 请求的语言已停用
+var ErrCultureDisabled = errors.New("culture is disabled")
+
+// negotiateCulture 协商本次请求使用的语言代码。
+// 请求显式指定语言代码时直接使用；否则根据 accept-language 元数据协商，见 NegotiateCulture。
+func negotiateCulture(ctx context.Context, repo repository.CulturesRepository, code string) (string, error) {
+	if code != "" {
+		return code, nil
+	}
+	cultures, err := repo.GetCultures()
+	if err != nil {
+		return "", err
+	}
+	return NegotiateCulture(cultures, NewMetadataContext(ctx).GetAcceptLanguage())
+}
+
+// NegotiateCulture 根据 Accept-Language 按 BCP 47 规则与语言表中启用的语言匹配，
+// 无法匹配时使用默认语言。测试和停用的语言不参与协商。
+func NegotiateCulture(cultures []entity.CulturesResources, acceptLanguage string) (string, error) {
+	var enabled []entity.CulturesResources
+	for _, v := range cultures {
+		if v.Status == repository.CultureEnabled || v.IsDefault {
+			enabled = append(enabled, v)
+		}
+	}
+	return matchCulture(enabled, acceptLanguage)
+}
+
+// ServedChain 过滤回退链上不向客户端提供的语言，非预览请求不提供停用的语言，
+// 请求的语言本身已停用时返回 ErrCultureDisabled。
+func ServedChain(chain []entity.CulturesResources, preview bool) ([]entity.CulturesResources, error) {
+	if preview {
+		return chain, nil
+	}
+	if chain[0].Status == repository.CultureDisabled {
+		return nil, fmt.Errorf("%w: %s", ErrCultureDisabled, chain[0].Code)
+	}
+	served := make([]entity.CulturesResources, 0, len(chain))
+	for _, v := range chain {
+		if v.Status != repository.CultureDisabled {
+			served = append(served, v)
+		}
+	}
+	return served, nil
+}
+
+// snapshotReplyCode 获取语言资源失败时的响应码
+func snapshotReplyCode(err error) proto.ReplyCode {
+	if errors.Is(err, ErrCultureDisabled) {
+		return proto.ReplyCode_NotFound
+	}
+	return proto.ReplyCode_DataBaseError
+}
+
+// matchCulture 根据 Accept-Language 从语言列表中选出最匹配的语言
+func matchCulture(cultures []entity.CulturesResources, acceptLanguage string) (string, error) {
+	// 默认语言放在首位，作为无法匹配时的结果
+	var supported []entity.CulturesResources
+	for _, v := range cultures {
+		if v.IsDefault {
+			supported = append([]entity.CulturesResources{v}, supported...)
+		} else {
+			supported = append(supported, v)
+		}
+	}
+	var cultureList []entity.CulturesResources
+	var tags []language.Tag
+	for _, v := range supported {
+		tag, err := language.Parse(v.Code)
+		if err != nil {
+			continue
+		}
+		tags = append(tags, tag)
+		cultureList = append(cultureList, v)
+	}
+	if len(cultureList) == 0 {
+		return "", errors.New("no culture available")
+	}
+	desired, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(desired) == 0 {
+		return cultureList[0].Code, nil
+	}
+	_, index, confidence := language.NewMatcher(tags).Match(desired...)
+	if confidence == language.No {
+		return cultureList[0].Code, nil
+	}
+	return cultureList[index].Code, nil
+}
@@ -3,10 +3,21 @@ package rpc
 import (
 	"context"
 	"errors"
+	"i18n-service/data/repository"
+	"strings"
 
 	"google.golang.org/grpc/metadata"
 )
 
+// accept-language 元数据键，gRPC 元数据键均为小写
+const acceptLanguageKey = "accept-language"
+
+// 操作人元数据键，记录到修改历史中
+const actorKey = "x-i18n-actor"
+
+// 项目元数据键，请求未指定 project 字段时使用
+const projectKey = "x-i18n-project"
+
 type MetadataContext struct {
 	context.Context
 }
@@ -51,3 +62,35 @@ func (c *MetadataContext) GetMetadata() map[string][]string {
 	}
 	return data
 }
+
+// GetAcceptLanguage 获取 accept-language 元数据，多个值以逗号合并
+func (c *MetadataContext) GetAcceptLanguage() string {
+	values, err := c.GetStringSlice(acceptLanguageKey)
+	if err != nil {
+		return ""
+	}
+	return strings.Join(values, ",")
+}
+
+// GetActor 获取操作人元数据，未设置时为空
+func (c *MetadataContext) GetActor() string {
+	actor, err := c.GetString(actorKey)
+	if err != nil {
+		return ""
+	}
+	return actor
+}
+
+// GetProject 获取项目元数据，未设置时为空
+func (c *MetadataContext) GetProject() string {
+	project, err := c.GetString(projectKey)
+	if err != nil {
+		return ""
+	}
+	return project
+}
+
+// actorContext 将元数据中的操作人写入上下文，供仓库记录修改历史
+func actorContext(ctx context.Context) context.Context {
+	return repository.WithActor(ctx, NewMetadataContext(ctx).GetActor())
+}
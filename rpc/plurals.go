@@ -0,0 +1,43 @@
+package rpc
+
+import (
+	"fmt"
+	"i18n-service/data/entity"
+	"i18n-service/data/repository"
+	"i18n-service/msgformat"
+	"i18n-service/proto"
+	"strings"
+)
+
+// validatePlurals 校验翻译的复数形式：类别必须是 CLDR 复数类别，
+// 且必须包含该语言配置的复数规则要求的所有类别。
+func validatePlurals(cultures []entity.CulturesResources, values []*proto.CultureKeyValue) error {
+	byID := make(map[int32]entity.CulturesResources, len(cultures))
+	for _, v := range cultures {
+		byID[v.ID] = v
+	}
+	for _, v := range values {
+		if len(v.Plurals) == 0 {
+			continue
+		}
+		culture, ok := byID[v.CultureId]
+		if !ok {
+			return fmt.Errorf("culture %d not exists", v.CultureId)
+		}
+		for category := range v.Plurals {
+			if !msgformat.IsPluralCategory(category) {
+				return fmt.Errorf("culture %s: invalid plural category %q", culture.Code, category)
+			}
+		}
+		var missing []string
+		for _, category := range msgformat.PluralCategories(repository.PluralLocale(culture)) {
+			if _, ok := v.Plurals[category]; !ok {
+				missing = append(missing, category)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("culture %s missing plural categories: %s", culture.Code, strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"i18n-service/data/entity"
+	"i18n-service/data/repository"
+	"i18n-service/proto"
+
+	"github.com/jinzhu/copier"
+)
+
+// projectRepo 获取请求所属项目范围的仓库。
+// 项目代码优先使用请求中的 project 字段，为空时使用 x-i18n-project 元数据，都为空时为默认项目。
+func (c *CulturesRpc) projectRepo(ctx context.Context, code string) (repository.CulturesRepository, error) {
+	if code == "" {
+		code = NewMetadataContext(ctx).GetProject()
+	}
+	if code == "" {
+		return c.repo, nil
+	}
+	project, err := c.repo.GetProjectByCode(code)
+	if err != nil {
+		return nil, err
+	}
+	return c.repo.WithProject(project.ID), nil
+}
+
+// projectReplyCode 获取项目失败时的响应码
+func projectReplyCode(err error) proto.ReplyCode {
+	if errors.Is(err, repository.ErrProjectNotFound) {
+		return proto.ReplyCode_NotFound
+	}
+	return proto.ReplyCode_DataBaseError
+}
+
+// CulturesProjectFeature 根据不同的操作类型处理项目相关的请求。
+// 项目隔离资源类型、资源键及其翻译，并可以限定启用的语言，其它请求通过 project 字段或 x-i18n-project 元数据指定项目。
+// 该函数支持三种操作类型：List（列出项目）、AddOrUpdate（添加或更新项目及其启用的语言）、Delete（删除没有资源的项目）。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含操作类型和请求数据的结构体。
+//
+// 返回值:
+//
+//	*proto.CultureProjectsReply - 包含操作结果的响应对象。
+//	error - 错误对象，如果操作成功则为nil。
+func (c *CulturesRpc) CulturesProjectFeature(ctx context.Context, req *proto.CultureProjectsRequest) (*proto.CultureProjectsReply, error) {
+	switch req.Action {
+	case proto.ActionTypes_List:
+		var findKey string
+		if req.ParamData != nil {
+			findKey = req.ParamData.Code
+		}
+		projects, total, err := c.repo.GetCulturesProjectPager(int(req.Index), int(req.Size), findKey)
+		if err != nil {
+			return &proto.CultureProjectsReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+		}
+		ids := make([]int32, 0, len(projects))
+		for _, v := range projects {
+			ids = append(ids, v.ID)
+		}
+		cultures, err := c.repo.GetCulturesProjectCultures(ids)
+		if err != nil {
+			return &proto.CultureProjectsReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+		}
+		var data []*proto.CultureProjectItem
+		for _, project := range projects {
+			var item proto.CultureProjectItem
+			copier.Copy(&item, project) // 自动映射字段
+			item.CultureIds = cultures[project.ID]
+			data = append(data, &item)
+		}
+		return &proto.CultureProjectsReply{Items: data, Total: total, Code: proto.ReplyCode_Success}, nil
+	case proto.ActionTypes_AddOrUpdate:
+		if req.ParamData == nil || req.ParamData.Code == "" {
+			return &proto.CultureProjectsReply{Message: "param data is null", Code: proto.ReplyCode_InvalidParam}, nil
+		}
+		project := &entity.CulturesProjects{}
+		if err := copier.Copy(project, req.ParamData); err != nil {
+			return &proto.CultureProjectsReply{Message: err.Error(), Code: proto.ReplyCode_Error}, nil
+		}
+		if _, err := c.repo.AddOrUpdateCulturesProject(*project, req.ParamData.CultureIds); err != nil {
+			return &proto.CultureProjectsReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+		}
+		return &proto.CultureProjectsReply{Code: proto.ReplyCode_Success, Message: "ok"}, nil
+	case proto.ActionTypes_Delete:
+		if req.ParamData == nil || req.ParamData.Id <= 0 {
+			return &proto.CultureProjectsReply{Message: "param data is null", Code: proto.ReplyCode_InvalidParam}, nil
+		}
+		if err := c.repo.DeleteCulturesProject(req.ParamData.Id); err != nil {
+			if errors.Is(err, repository.ErrProjectInUse) {
+				return &proto.CultureProjectsReply{Message: err.Error(), Code: proto.ReplyCode_DataExists}, nil
+			}
+			return &proto.CultureProjectsReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+		}
+		return &proto.CultureProjectsReply{Code: proto.ReplyCode_Success, Message: "ok"}, nil
+	}
+	return &proto.CultureProjectsReply{Code: proto.ReplyCode_InvalidAction, Message: "not support action " + req.Action.String()}, nil
+}
@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"i18n-service/data/repository"
+	"i18n-service/proto"
+)
+
+// cultureSnapshots 获取语言回退链上每个语言的资源快照，第一个为请求的语言本身。
+// preview 为 false 时跳过停用的语言，请求的语言本身停用时返回 ErrCultureDisabled。
+func cultureSnapshots(repo repository.CulturesRepository, code string, preview bool) ([]*repository.CultureSnapshot, error) {
+	chain, err := repo.GetCultureFallbackChain(code)
+	if err != nil {
+		return nil, err
+	}
+	if chain, err = ServedChain(chain, preview); err != nil {
+		return nil, err
+	}
+	snapshots := make([]*repository.CultureSnapshot, 0, len(chain))
+	for _, culture := range chain {
+		snapshot, err := repo.GetCultureSnapshot(culture.Code)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// resourceKeys 获取资源键，没有过滤条件时使用缓存的全量资源键，否则按条件查询数据库。
+func resourceKeys(repo repository.CulturesRepository, filter repository.ResourceKeyFilter) (map[int32]string, error) {
+	if filter.IsEmpty() {
+		return repo.GetCulturesResourceKeys()
+	}
+	return repo.GetCulturesResourceKeysByFilter(filter)
+}
+
+// cultureResourceItems 根据回退链快照构建资源项列表，preview 为 true 时包含未审核的翻译。
+func cultureResourceItems(snapshots []*repository.CultureSnapshot, keyData map[int32]string, preview bool) []*proto.CultureResourceItem {
+	var items []*proto.CultureResourceItem
+	for id, name := range keyData {
+		items = append(items, cultureResourceItem(snapshots, id, name, preview))
+	}
+	return items
+}
+
+// culturesResourceItems 一次遍历资源键，为多个语言的回退链快照构建资源项列表，
+// 快照为 nil 的语言跳过。
+func culturesResourceItems(bundles [][]*repository.CultureSnapshot, keyData map[int32]string, preview bool) [][]*proto.CultureResourceItem {
+	items := make([][]*proto.CultureResourceItem, len(bundles))
+	for id, name := range keyData {
+		for i, snapshots := range bundles {
+			if snapshots == nil {
+				continue
+			}
+			items[i] = append(items[i], cultureResourceItem(snapshots, id, name, preview))
+		}
+	}
+	return items
+}
+
+// cultureResourceItem 构建单个资源项，按回退链依次查找可见的翻译，
+// 都没有翻译时使用键名作为文本，此时来源语言为空。
+func cultureResourceItem(snapshots []*repository.CultureSnapshot, keyID int32, name string, preview bool) *proto.CultureResourceItem {
+	for _, snapshot := range snapshots {
+		if text := snapshot.Texts[keyID]; text != "" && snapshot.Visible(keyID, preview) {
+			return &proto.CultureResourceItem{Key: name, Text: text, Culture: snapshot.Culture.Code, Plurals: snapshot.Plurals[keyID]}
+		}
+	}
+	return &proto.CultureResourceItem{Key: name, Text: name}
+}
+
+// resourceKeyFilter 从请求中读取资源键过滤条件
+func resourceKeyFilter(req *proto.CultureCodeRequest) repository.ResourceKeyFilter {
+	return repository.ResourceKeyFilter{
+		TypeIDs:   req.TypeIds,
+		TypeNames: req.TypeNames,
+		Prefixes:  req.KeyPrefixes,
+		Patterns:  req.KeyPatterns,
+		Tags:      req.Tags,
+	}
+}
+
+// culturesKeyFilter 从多语言请求中读取资源键过滤条件
+func culturesKeyFilter(req *proto.CultureCodesRequest) repository.ResourceKeyFilter {
+	return repository.ResourceKeyFilter{
+		TypeIDs:   req.TypeIds,
+		TypeNames: req.TypeNames,
+		Prefixes:  req.KeyPrefixes,
+		Patterns:  req.KeyPatterns,
+		Tags:      req.Tags,
+	}
+}
+
+// containsCulture 判断语言是否在回退链中
+func containsCulture(snapshots []*repository.CultureSnapshot, cultureID int32) bool {
+	for _, snapshot := range snapshots {
+		if snapshot.Culture.ID == cultureID {
+			return true
+		}
+	}
+	return false
+}
@@ -2,13 +2,16 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"i18n-service/config"
 	"i18n-service/data/entity"
 	"i18n-service/data/repository"
 	"i18n-service/proto"
 	"log"
+	"time"
 
 	"github.com/jinzhu/copier"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type CulturesRpc struct {
@@ -32,6 +35,11 @@ func NewCulturesRpc(cfgManager *config.ConfigManager) *CulturesRpc {
 	}
 }
 
+// Close 停止后台任务并关闭仓库的数据库连接，服务停止后调用。
+func (c *CulturesRpc) Close() error {
+	return c.repo.Close()
+}
+
 // CultureFeature 处理文化特征的相关请求。
 // 该方法根据传入的Action类型执行不同的操作，支持列出文化特征和添加或更新文化特征。
 // 参数:
@@ -49,11 +57,17 @@ func (c *CulturesRpc) CultureFeature(ctx context.Context, req *proto.CulturesReq
 	// 	log.Println("metadata:", k, v)
 	// }
 
+	// 语言由所有项目共享，列表只返回项目启用的语言。
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CulturesReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+
 	// 根据请求的Action类型执行相应的逻辑。
 	switch req.Action {
 	case proto.ActionTypes_List:
 		// 处理列出文化特征的请求。
-		cultures, err := c.repo.GetCultures()
+		cultures, err := repo.GetCultures()
 		if err != nil {
 			// 如果从数据库获取文化特征时发生错误，返回错误响应。
 			return &proto.CulturesReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
@@ -62,6 +76,7 @@ func (c *CulturesRpc) CultureFeature(ctx context.Context, req *proto.CulturesReq
 		for _, culture := range cultures {
 			var item proto.CultureItem
 			copier.Copy(&item, culture) // 自动映射字段
+			item.Direction = repository.CultureDirection(culture)
 			data = append(data, &item)
 		}
 		// 返回成功响应，包含文化特征列表。
@@ -78,7 +93,10 @@ func (c *CulturesRpc) CultureFeature(ctx context.Context, req *proto.CulturesReq
 			// 如果参数数据复制到文化特征资源时发生错误，返回错误响应。
 			return &proto.CulturesReply{Message: err.Error(), Code: proto.ReplyCode_Error}, nil
 		}
-		if err := c.repo.AddOrUpdateCultures(*culture); err != nil {
+		if err := repo.AddOrUpdateCultures(*culture); err != nil {
+			if errors.Is(err, repository.ErrInvalidCulture) {
+				return &proto.CulturesReply{Message: err.Error(), Code: proto.ReplyCode_InvalidData}, nil
+			}
 			// 如果添加或更新数据库中的文化特征时发生错误，返回错误响应。
 			return &proto.CulturesReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
 		}
@@ -102,6 +120,10 @@ func (c *CulturesRpc) CultureFeature(ctx context.Context, req *proto.CulturesReq
 //	*proto.CulturesTypesReply - 包含操作结果或其他相关信息的回复结构体。
 //	error - 错误信息，如果操作成功则为nil。
 func (c *CulturesRpc) CulturesResourceTypeFeature(ctx context.Context, req *proto.CultureTypesRequest) (*proto.CulturesTypesReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CulturesTypesReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
 	switch req.Action {
 	case proto.ActionTypes_List:
 		// 处理列表操作，根据请求的条件获取文化资源类型列表和总数。
@@ -110,14 +132,14 @@ func (c *CulturesRpc) CulturesResourceTypeFeature(ctx context.Context, req *prot
 		var err error
 		if len(req.CultureIds) > 0 {
 			// 如果请求中包含文化ID列表，则根据这些ID获取文化资源类型。
-			cultures, err = c.repo.GetCulturesResourceTypeByIds(req.CultureIds)
+			cultures, err = repo.GetCulturesResourceTypeByIds(req.CultureIds)
 		} else {
 			// 否则，根据请求的分页信息和查找关键词获取文化资源类型列表和总数。
 			var findKey string
 			if req.ParamData != nil {
 				findKey = req.ParamData.Name
 			}
-			cultures, total, err = c.repo.GetCulturesResourceTypePager(int(req.Index), int(req.Size), findKey)
+			cultures, total, err = repo.GetCulturesResourceTypePager(int(req.Index), int(req.Size), findKey)
 		}
 		if err != nil {
 			// 如果发生错误，返回错误信息和数据库错误代码。
@@ -148,7 +170,10 @@ func (c *CulturesRpc) CulturesResourceTypeFeature(ctx context.Context, req *prot
 			return &proto.CulturesTypesReply{Message: err.Error(), Code: proto.ReplyCode_Error}, nil
 		}
 		// 调用仓库方法添加或更新文化资源类型。
-		if err := c.repo.AddOrUpdateCulturesResourceType(*culture); err != nil {
+		if err := repo.AddOrUpdateCulturesResourceType(actorContext(ctx), *culture); err != nil {
+			if errors.Is(err, repository.ErrTypeNotFound) {
+				return &proto.CulturesTypesReply{Message: err.Error(), Code: proto.ReplyCode_NotFound}, nil
+			}
 			return &proto.CulturesTypesReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
 		}
 		// 返回成功回复。
@@ -160,8 +185,11 @@ func (c *CulturesRpc) CulturesResourceTypeFeature(ctx context.Context, req *prot
 			// 如果请求数据为空或ID无效，返回无效参数错误。
 			return &proto.CulturesTypesReply{Message: "param data is null", Code: proto.ReplyCode_InvalidParam}, nil
 		}
-		// 调用仓库方法删除文化资源类型。
-		if err := c.repo.DeleteCulturesResourceType(req.ParamData.Id); err != nil {
+		// 调用仓库方法删除文化资源类型，仍被资源键引用时按请求改为其它类型或拒绝删除。
+		if err := repo.DeleteCulturesResourceType(actorContext(ctx), req.ParamData.Id, req.ReassignTypeId); err != nil {
+			if errors.Is(err, repository.ErrTypeInUse) {
+				return &proto.CulturesTypesReply{Message: err.Error(), Code: proto.ReplyCode_DataExists}, nil
+			}
 			return &proto.CulturesTypesReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
 		}
 		// 返回成功回复。
@@ -187,6 +215,10 @@ func (c *CulturesRpc) CulturesResourceTypeFeature(ctx context.Context, req *prot
 //	*proto.CultureKeysReply - 包含操作结果的响应对象。
 //	error - 错误对象，如果操作成功则为nil。
 func (c *CulturesRpc) CulturesResourceKeyFeature(ctx context.Context, req *proto.CultureKeysRequest) (*proto.CultureKeysReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CultureKeysReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
 	switch req.Action {
 	case proto.ActionTypes_List:
 		// 处理列出文化资源键的请求。
@@ -194,17 +226,23 @@ func (c *CulturesRpc) CulturesResourceKeyFeature(ctx context.Context, req *proto
 		if req.ParamData != nil {
 			findKey = req.ParamData.Name
 		}
-		cultures, total, err := c.repo.GetCulturesResourceKeyPager(int(req.Index), int(req.Size), findKey)
+		cultures, total, err := repo.GetCulturesResourceKeyPager(int(req.Index), int(req.Size), findKey, req.Tags)
 		if err != nil {
 			return &proto.CultureKeysReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
 		}
 		var tids []int32
+		var kids []int32
 		for _, v := range cultures {
 			tids = append(tids, int32(v.TypeID))
+			kids = append(kids, v.ID)
+		}
+		tags, err := repo.GetCulturesResourceKeyTags(kids)
+		if err != nil {
+			return &proto.CultureKeysReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
 		}
 		types := make(map[int32]string)
 		if len(tids) > 0 {
-			cultureTypes, _ := c.repo.GetCulturesResourceTypeByIds(tids)
+			cultureTypes, _ := repo.GetCulturesResourceTypeByIds(tids)
 			for _, item := range cultureTypes {
 				types[item.ID] = item.Name
 			}
@@ -216,6 +254,7 @@ func (c *CulturesRpc) CulturesResourceKeyFeature(ctx context.Context, req *proto
 			if types[culture.TypeID] != "" {
 				item.TypeName = types[culture.TypeID]
 			}
+			item.Tags = tags[culture.ID]
 			data = append(data, &item)
 		}
 		return &proto.CultureKeysReply{Items: data, Total: total}, nil
@@ -228,7 +267,10 @@ func (c *CulturesRpc) CulturesResourceKeyFeature(ctx context.Context, req *proto
 		if err := copier.Copy(culture, req.ParamData); err != nil {
 			return &proto.CultureKeysReply{Message: err.Error(), Code: proto.ReplyCode_Error}, nil
 		}
-		if _, err := c.repo.AddOrUpdateCulturesResourceKey(*culture); err != nil {
+		if _, err := repo.AddOrUpdateCulturesResourceKey(actorContext(ctx), *culture); err != nil {
+			if errors.Is(err, repository.ErrTypeNotFound) {
+				return &proto.CultureKeysReply{Message: err.Error(), Code: proto.ReplyCode_InvalidParam}, nil
+			}
 			return &proto.CultureKeysReply{Message: err.Error()}, nil
 		}
 		return &proto.CultureKeysReply{Code: proto.ReplyCode_Success, Message: "ok"}, nil
@@ -237,20 +279,171 @@ func (c *CulturesRpc) CulturesResourceKeyFeature(ctx context.Context, req *proto
 		if req.ParamData == nil || req.ParamData.Id <= 0 {
 			return &proto.CultureKeysReply{Message: "param data is null", Code: proto.ReplyCode_InvalidParam}, nil
 		}
-		if err := c.repo.DeleteCulturesResourceKey(req.ParamData.Id); err != nil {
+		if err := repo.DeleteCulturesResourceKey(actorContext(ctx), req.ParamData.Id); err != nil {
 			return &proto.CultureKeysReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
 		}
+		return &proto.CultureKeysReply{Code: proto.ReplyCode_Success, Message: "ok"}, nil
 	}
 	// 如果操作类型不匹配任何已知操作，返回错误响应。
 	return &proto.CultureKeysReply{Code: proto.ReplyCode_InvalidAction, Message: "not support action " + req.Action.String()}, nil
 }
 
+// CulturesResourceTagFeature 根据不同的操作类型处理资源标签相关的请求。
+// 该函数支持三种操作类型：List（列出标签）、AddOrUpdate（添加或更新标签）、Delete（删除标签及其与资源键的关联）。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含操作类型和请求数据的结构体。
+//
+// 返回值:
+//
+//	*proto.CultureTagsReply - 包含操作结果的响应对象。
+//	error - 错误对象，如果操作成功则为nil。
+func (c *CulturesRpc) CulturesResourceTagFeature(ctx context.Context, req *proto.CultureTagsRequest) (*proto.CultureTagsReply, error) {
+	// 标签属于项目，只能为同一项目的资源键设置
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CultureTagsReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	switch req.Action {
+	case proto.ActionTypes_List:
+		var findKey string
+		if req.ParamData != nil {
+			findKey = req.ParamData.Name
+		}
+		tags, total, err := repo.GetCulturesResourceTagPager(int(req.Index), int(req.Size), findKey)
+		if err != nil {
+			return &proto.CultureTagsReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+		}
+		var data []*proto.CultureTagItem
+		for _, tag := range tags {
+			var item proto.CultureTagItem
+			copier.Copy(&item, tag) // 自动映射字段
+			data = append(data, &item)
+		}
+		return &proto.CultureTagsReply{Items: data, Total: total, Code: proto.ReplyCode_Success}, nil
+	case proto.ActionTypes_AddOrUpdate:
+		if req.ParamData == nil {
+			return &proto.CultureTagsReply{Message: "param data is null", Code: proto.ReplyCode_InvalidParam}, nil
+		}
+		tag := &entity.CulturesResourceTags{}
+		if err := copier.Copy(tag, req.ParamData); err != nil {
+			return &proto.CultureTagsReply{Message: err.Error(), Code: proto.ReplyCode_Error}, nil
+		}
+		if err := repo.AddOrUpdateCulturesResourceTag(*tag); err != nil {
+			return &proto.CultureTagsReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+		}
+		return &proto.CultureTagsReply{Code: proto.ReplyCode_Success, Message: "ok"}, nil
+	case proto.ActionTypes_Delete:
+		if req.ParamData == nil || req.ParamData.Id <= 0 {
+			return &proto.CultureTagsReply{Message: "param data is null", Code: proto.ReplyCode_InvalidParam}, nil
+		}
+		if err := repo.DeleteCulturesResourceTag(req.ParamData.Id); err != nil {
+			return &proto.CultureTagsReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+		}
+		return &proto.CultureTagsReply{Code: proto.ReplyCode_Success, Message: "ok"}, nil
+	}
+	return &proto.CultureTagsReply{Code: proto.ReplyCode_InvalidAction, Message: "not support action " + req.Action.String()}, nil
+}
+
+// TagResourceKeys 为多个资源键添加或移除标签，添加的标签不存在时自动创建。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含资源键ID列表、添加和移除的标签名称的请求对象。
+//
+// 返回值:
+//
+//	*proto.CultureBaseReply - 操作结果。
+//	error - 错误对象。
+func (c *CulturesRpc) TagResourceKeys(ctx context.Context, req *proto.TagResourceKeysRequest) (*proto.CultureBaseReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CultureBaseReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	if len(req.KeyIds) == 0 || len(req.AddTags) == 0 && len(req.RemoveTags) == 0 {
+		return &proto.CultureBaseReply{Message: "param data is null", Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	if err := repo.TagCulturesResourceKeys(req.KeyIds, req.AddTags, req.RemoveTags); err != nil {
+		return &proto.CultureBaseReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+	return &proto.CultureBaseReply{Code: proto.ReplyCode_Success, Message: "ok"}, nil
+}
+
+// AddResourceKeyValue 添加资源键及其多个语言的翻译。
+// 翻译携带复数形式时，校验每个语言是否提供了其 CLDR 复数规则要求的所有类别。
+// 翻译按草稿或待审核保存，未指定时为草稿，其他状态按待审核保存，只能通过 TransitionResourceLangs 审核通过，审核通过前不会返回给客户端。
+// 资源键配置了最大字符数时，超过长度的翻译会被拒绝。
+// 翻译的占位符（{name}、%s、{{count}}）与默认语言的翻译不一致时会被拒绝。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含资源键、资源类型和各语言翻译的请求对象。
+//
+// 返回值:
+//
+//	*proto.CultureBaseReply - 操作结果。
+//	error - 错误对象。
 func (c *CulturesRpc) AddResourceKeyValue(ctx context.Context, req *proto.AddCultureKeyValueRequest) (*proto.CultureBaseReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CultureBaseReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	cultures, err := repo.GetCultures()
+	if err != nil {
+		return &proto.CultureBaseReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+	if err := validatePlurals(cultures, req.Values); err != nil {
+		return &proto.CultureBaseReply{Message: err.Error(), Code: proto.ReplyCode_InvalidData}, nil
+	}
 	var cultureLang []entity.CulturesResourceLangs
 	for _, v := range req.Values {
-		cultureLang = append(cultureLang, entity.CulturesResourceLangs{CultureID: v.CultureId, Text: v.Text})
+		text := v.Text
+		if text == "" && len(v.Plurals) > 0 {
+			text = v.Plurals["other"]
+		}
+		cultureLang = append(cultureLang, entity.CulturesResourceLangs{CultureID: v.CultureId, Text: text, Plurals: v.Plurals, Status: int32(v.Status)})
+	}
+	if err := repo.AddCulturesResourceLangs(actorContext(ctx), req.Key, req.TypeId, cultureLang); err != nil {
+		if errors.Is(err, repository.ErrTextTooLong) || errors.Is(err, repository.ErrPlaceholderMismatch) {
+			return &proto.CultureBaseReply{Message: err.Error(), Code: proto.ReplyCode_InvalidData}, nil
+		}
+		if errors.Is(err, repository.ErrTypeNotFound) {
+			return &proto.CultureBaseReply{Message: err.Error(), Code: proto.ReplyCode_InvalidParam}, nil
+		}
+		return &proto.CultureBaseReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+	return &proto.CultureBaseReply{Code: proto.ReplyCode_Success, Message: "ok"}, nil
+}
+
+// TransitionResourceLangs 流转语言翻译的审核状态。
+// 允许的流转为：草稿 → 待审核，待审核 → 通过/驳回/草稿，驳回 → 草稿/待审核，通过 → 待审核。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含翻译ID列表、目标状态和审核人的请求对象，所有翻译在同一事务中流转，审核人为空时使用操作人。
+//
+// 返回值:
+//
+//	*proto.CultureBaseReply - 操作结果，任一翻译不允许流转时返回 InvalidAction 且不做任何修改。
+//	error - 错误对象。
+func (c *CulturesRpc) TransitionResourceLangs(ctx context.Context, req *proto.TransitionLangsRequest) (*proto.CultureBaseReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CultureBaseReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
 	}
-	if err := c.repo.AddCulturesResourceLangs(req.Key, req.TypeId, cultureLang); err != nil {
+	if len(req.Ids) == 0 {
+		return &proto.CultureBaseReply{Message: "ids is empty", Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	ctx = actorContext(ctx)
+	reviewer := req.Reviewer
+	if reviewer == "" {
+		reviewer = NewMetadataContext(ctx).GetActor()
+	}
+	if err := repo.TransitionCulturesResourceLangs(ctx, req.Ids, int32(req.Status), reviewer); err != nil {
+		if errors.Is(err, repository.ErrInvalidTransition) {
+			return &proto.CultureBaseReply{Message: err.Error(), Code: proto.ReplyCode_InvalidAction}, nil
+		}
 		return &proto.CultureBaseReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
 	}
 	return &proto.CultureBaseReply{Code: proto.ReplyCode_Success, Message: "ok"}, nil
@@ -261,53 +454,156 @@ func (c *CulturesRpc) AddResourceKeyValue(ctx context.Context, req *proto.AddCul
 // 参数:
 //
 //	ctx - 上下文，用于传递请求范围的 deadline、取消信号等。
-//	req - 包含文化代码的请求对象。
+//	req - 包含文化代码的请求对象，代码为空时根据 accept-language 元数据协商语言；
+//	      携带 if_none_match 且资源包未变化时返回 NotModified；
+//	      preview 为 true 时包含草稿和待审核的翻译，否则只返回审核通过的翻译。
 //
 // 返回值:
 //
-//	*proto.CultureResourcesReply - 包含文化资源信息的响应对象，包括响应码、消息和资源项列表。
+//	*proto.CultureResourcesReply - 包含文化资源信息的响应对象，包括响应码、消息、实际使用的语言和资源项列表。
 //	error - 错误对象，如果在处理请求过程中遇到错误，则可能不为 nil。
 func (c *CulturesRpc) GetCultureResources(ctx context.Context, req *proto.CultureCodeRequest) (*proto.CultureResourcesReply, error) {
-	// 检查请求参数是否为空，如果为空则返回错误响应。
-	if req.Code == "" {
-		return &proto.CultureResourcesReply{Message: "param data is null", Code: proto.ReplyCode_InvalidParam}, nil
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CultureResourcesReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
 	}
-
-	// 根据文化代码从数据库中获取资源。
-	resource, err := c.repo.GetResourcesByCode(req.Code)
+	// 未指定语言代码时，根据 accept-language 元数据协商语言。
+	code, err := negotiateCulture(ctx, repo, req.Code)
 	if err != nil {
-		// 如果数据库操作失败，则返回错误响应。
 		return &proto.CultureResourcesReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
 	}
 
-	// 获取文化资源的键值对数据。
-	keyData, ex := c.repo.GetCulturesResourceKeys()
+	// 根据文化代码获取回退链上的资源快照，优先从缓存读取。
+	// 非预览请求不提供停用的语言。
+	snapshots, err := cultureSnapshots(repo, code, req.Preview)
+	if err != nil {
+		// 如果语言已停用或数据库操作失败，则返回错误响应。
+		return &proto.CultureResourcesReply{Message: err.Error(), Code: snapshotReplyCode(err)}, nil
+	}
+
+	// 获取文化资源的键值对数据，按资源类型和键名过滤。
+	keyData, ex := resourceKeys(repo, resourceKeyFilter(req))
 	if ex != nil {
 		// 如果获取键值对数据失败，则返回错误响应。
 		return &proto.CultureResourcesReply{Message: ex.Error(), Code: proto.ReplyCode_DataBaseError}, nil
 	}
 
-	// 初始化一个映射，用于存储资源的键值对。
-	langs := make(map[int32]string)
-	for _, v := range resource {
-		langs[v.KeyID] = v.Text
+	// 计算资源包版本，与客户端持有的版本一致时不返回资源项。
+	version := repository.BundleVersion(snapshots, keyData, req.Preview)
+	if req.IfNoneMatch != "" && req.IfNoneMatch == version {
+		return &proto.CultureResourcesReply{Code: proto.ReplyCode_NotModified, Message: "not modified", Culture: snapshots[0].Culture.Code, Direction: repository.CultureDirection(snapshots[0].Culture), Version: version}, nil
 	}
 
-	// 初始化文化资源项列表。
-	var culture []*proto.CultureResourceItem
-	var text = ""
-	for id, v := range keyData {
-		if langs[id] != "" {
-			text = langs[id]
-		} else {
-			text = v
+	// 根据快照构建文化资源项列表，缺失的翻译按回退链查找。
+	culture := cultureResourceItems(snapshots, keyData, req.Preview)
+
+	// 需要嵌套结构时，按点分隔的键名构建资源树。
+	if req.Nested {
+		tree, conflicts, err := BuildResourceTree(culture)
+		if err != nil {
+			return &proto.CultureResourcesReply{Message: err.Error(), Code: proto.ReplyCode_Error}, nil
 		}
-		// 构建文化资源项并添加到列表中。
-		culture = append(culture, &proto.CultureResourceItem{Key: v, Text: text})
+		return &proto.CultureResourcesReply{Code: proto.ReplyCode_Success, Message: "ok", Tree: tree, Conflicts: conflicts, Culture: snapshots[0].Culture.Code, Direction: repository.CultureDirection(snapshots[0].Culture), Version: version}, nil
 	}
 
 	// 返回成功响应，包含文化资源项列表。
-	return &proto.CultureResourcesReply{Code: proto.ReplyCode_Success, Message: "ok", Items: culture}, nil
+	return &proto.CultureResourcesReply{Code: proto.ReplyCode_Success, Message: "ok", Items: culture, Culture: snapshots[0].Culture.Code, Direction: repository.CultureDirection(snapshots[0].Culture), Version: version}, nil
+}
+
+// GetCulturesResources 一次获取多个文化的资源。
+// 资源键只读取一次，并在一次遍历中为每个语言构建资源项，避免多次调用 GetCultureResources。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的 deadline、取消信号等。
+//	req - 包含多个文化代码的请求对象，过滤条件对所有语言生效；
+//	      某个语言携带的 if_none_match 与当前版本一致时，该语言返回 NotModified。
+//
+// 返回值:
+//
+//	*proto.CulturesResourcesReply - 按请求的语言代码返回各语言的资源响应，单个语言失败不影响其它语言。
+//	error - 错误对象，如果在处理请求过程中遇到错误，则可能不为 nil。
+func (c *CulturesRpc) GetCulturesResources(ctx context.Context, req *proto.CultureCodesRequest) (*proto.CulturesResourcesReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CulturesResourcesReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	if len(req.Codes) == 0 {
+		return &proto.CulturesResourcesReply{Message: "codes is empty", Code: proto.ReplyCode_InvalidParam}, nil
+	}
+
+	// 所有语言共用一次资源键查询。
+	keyData, err := resourceKeys(repo, culturesKeyFilter(req))
+	if err != nil {
+		return &proto.CulturesResourcesReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+
+	cultures := make(map[string]*proto.CultureResourcesReply, len(req.Codes))
+	var codes []string
+	var bundles [][]*repository.CultureSnapshot
+	for _, code := range req.Codes {
+		if _, ok := cultures[code]; ok {
+			continue
+		}
+		snapshots, err := cultureSnapshots(repo, code, req.Preview)
+		if err != nil {
+			cultures[code] = &proto.CultureResourcesReply{Message: err.Error(), Code: snapshotReplyCode(err)}
+			continue
+		}
+		version := repository.BundleVersion(snapshots, keyData, req.Preview)
+		reply := &proto.CultureResourcesReply{Code: proto.ReplyCode_Success, Message: "ok", Culture: snapshots[0].Culture.Code, Direction: repository.CultureDirection(snapshots[0].Culture), Version: version}
+		cultures[code] = reply
+		if match := req.IfNoneMatch[code]; match != "" && match == version {
+			reply.Code = proto.ReplyCode_NotModified
+			reply.Message = "not modified"
+			continue
+		}
+		codes = append(codes, code)
+		bundles = append(bundles, snapshots)
+	}
+
+	// 一次遍历资源键，为所有需要返回的语言构建资源项。
+	for i, items := range culturesResourceItems(bundles, keyData, req.Preview) {
+		reply := cultures[codes[i]]
+		if !req.Nested {
+			reply.Items = items
+			continue
+		}
+		tree, conflicts, err := BuildResourceTree(items)
+		if err != nil {
+			reply.Code = proto.ReplyCode_Error
+			reply.Message = err.Error()
+			continue
+		}
+		reply.Tree = tree
+		reply.Conflicts = conflicts
+	}
+	return &proto.CulturesResourcesReply{Code: proto.ReplyCode_Success, Message: "ok", Cultures: cultures}, nil
+}
+
+// GetResourceCacheStats 获取翻译资源快照缓存的统计信息。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的 deadline、取消信号等。
+//	req - 包含项目代码的请求对象，缓存按项目隔离。
+//
+// 返回值:
+//
+//	*proto.CacheStatsReply - 包含命中、未命中、淘汰次数及当前占用的响应对象。
+//	error - 错误对象。
+func (c *CulturesRpc) GetResourceCacheStats(ctx context.Context, req *proto.CacheStatsRequest) (*proto.CacheStatsReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CacheStatsReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	stats := repo.GetCacheStats()
+	return &proto.CacheStatsReply{
+		Hits:      stats.Hits,
+		Misses:    stats.Misses,
+		Evictions: stats.Evictions,
+		Entries:   int32(stats.Entries),
+		Bytes:     stats.Bytes,
+		Code:      proto.ReplyCode_Success,
+	}, nil
 }
 
 // CulturesResourceKeyValueFeature 处理文化资源的键值对特征请求。
@@ -322,6 +618,10 @@ func (c *CulturesRpc) GetCultureResources(ctx context.Context, req *proto.Cultur
 //	*proto.CultureKeyValuesReply - 包含操作结果或数据的响应对象。
 //	error - 错误对象，如果发生错误。
 func (c *CulturesRpc) CulturesResourceKeyValueFeature(ctx context.Context, req *proto.CultureKeyValuesRequest) (*proto.CultureKeyValuesReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CultureKeyValuesReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
 	// 根据请求的动作类型执行相应的操作。
 	switch req.Action {
 	case proto.ActionTypes_List:
@@ -335,8 +635,13 @@ func (c *CulturesRpc) CulturesResourceKeyValueFeature(ctx context.Context, req *
 		if req.ParamData != nil {
 			cultureId = req.ParamData.CultureId
 		}
+		// 按审核状态过滤，例如只列出待审核的翻译。
+		var statuses []int32
+		for _, v := range req.Statuses {
+			statuses = append(statuses, int32(v))
+		}
 		// 调用仓库方法获取分页的文化资源数据。
-		cultures, total, err := c.repo.GetCulturesResourceLangPager(int(req.Index), int(req.Size), int(cultureId), findKey)
+		cultures, total, err := repo.GetCulturesResourceLangPager(int(req.Index), int(req.Size), int(cultureId), findKey, statuses, req.Tags)
 		if err != nil {
 			// 如果发生错误，返回错误响应。
 			return &proto.CultureKeyValuesReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
@@ -346,6 +651,12 @@ func (c *CulturesRpc) CulturesResourceKeyValueFeature(ctx context.Context, req *
 		for _, culture := range cultures {
 			var item proto.CultureKeyValueItem
 			copier.Copy(&item, culture)
+			// 时间字段类型不同，copier 不会映射，需要单独转换
+			item.CreatedAt = timestamppb.New(culture.CreatedAt)
+			item.UpdatedAt = timestamppb.New(culture.UpdatedAt)
+			if culture.ReviewedAt != nil {
+				item.ReviewedAt = timestamppb.New(*culture.ReviewedAt)
+			}
 			data = append(data, &item)
 		}
 		// 返回成功响应，包含查询到的数据和总记录数。
@@ -355,3 +666,150 @@ func (c *CulturesRpc) CulturesResourceKeyValueFeature(ctx context.Context, req *
 	// 如果请求的动作类型不受支持，返回错误响应。
 	return &proto.CultureKeyValuesReply{Code: proto.ReplyCode_InvalidAction, Message: "not support action " + req.Action.String()}, nil
 }
+
+// GetResourceHistory 获取资源键或资源类型的修改历史。
+// 指定资源键时返回该资源键本身及其所有语言翻译的修改历史，按时间倒序分页返回。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含资源键ID或资源类型ID、语言过滤条件和分页信息的请求对象。
+//
+// 返回值:
+//
+//	*proto.ResourceHistoryReply - 包含历史记录列表和总记录数的响应对象。
+//	error - 错误对象。
+func (c *CulturesRpc) GetResourceHistory(ctx context.Context, req *proto.ResourceHistoryRequest) (*proto.ResourceHistoryReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.ResourceHistoryReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	if req.KeyId <= 0 && req.TypeId <= 0 {
+		return &proto.ResourceHistoryReply{Message: "key_id or type_id is required", Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	filter := repository.HistoryFilter{KeyID: req.KeyId, TypeID: req.TypeId, CultureIDs: req.CultureIds}
+	histories, total, err := repo.GetCulturesResourceHistoryPager(int(req.Index), int(req.Size), filter)
+	if err != nil {
+		return &proto.ResourceHistoryReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+	var data []*proto.ResourceHistoryItem
+	for _, history := range histories {
+		var item proto.ResourceHistoryItem
+		copier.Copy(&item, history)
+		item.CreatedAt = timestamppb.New(history.CreatedAt)
+		data = append(data, &item)
+	}
+	return &proto.ResourceHistoryReply{Items: data, Total: total, Code: proto.ReplyCode_Success}, nil
+}
+
+// RestoreResourceRevision 将资源还原到指定的历史版本。
+// 还原为该版本修改后的值，删除记录则还原为删除前的值；还原本身作为一次修改记录历史。
+// 已删除的资源键按原ID重建，语言翻译只能在其资源键存在时还原，还原的翻译为待审核状态并清除审核人。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含历史记录ID的请求对象。
+//
+// 返回值:
+//
+//	*proto.CultureBaseReply - 操作结果。
+//	error - 错误对象。
+func (c *CulturesRpc) RestoreResourceRevision(ctx context.Context, req *proto.RestoreRevisionRequest) (*proto.CultureBaseReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CultureBaseReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	if req.Id <= 0 {
+		return &proto.CultureBaseReply{Message: "param data is null", Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	if err := repo.RestoreCulturesResourceRevision(actorContext(ctx), req.Id); err != nil {
+		if errors.Is(err, repository.ErrTextTooLong) || errors.Is(err, repository.ErrPlaceholderMismatch) {
+			return &proto.CultureBaseReply{Message: err.Error(), Code: proto.ReplyCode_InvalidData}, nil
+		}
+		return &proto.CultureBaseReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+	return &proto.CultureBaseReply{Code: proto.ReplyCode_Success, Message: "ok"}, nil
+}
+
+// GetResourceTrash 获取回收站中的资源键或资源类型。
+// 软删除的数据在保留期内可以还原，超过保留期后永久删除。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含实体类型（key/type）和分页信息的请求对象。
+//
+// 返回值:
+//
+//	*proto.ResourceTrashReply - 包含回收站数据、删除时间和永久删除时间的响应对象。
+//	error - 错误对象。
+func (c *CulturesRpc) GetResourceTrash(ctx context.Context, req *proto.ResourceTrashRequest) (*proto.ResourceTrashReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.ResourceTrashReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	retention := repo.GetTrashRetention()
+	trashItem := func(id int64, name string, typeID int32, deletedAt time.Time) *proto.ResourceTrashItem {
+		item := &proto.ResourceTrashItem{Id: id, Entity: req.Entity, Name: name, TypeId: typeID, DeletedAt: timestamppb.New(deletedAt)}
+		if retention > 0 {
+			item.PurgeAt = timestamppb.New(deletedAt.Add(retention))
+		}
+		return item
+	}
+	var data []*proto.ResourceTrashItem
+	var total int64
+	switch req.Entity {
+	case repository.HistoryEntityKey:
+		keys, count, err := repo.GetDeletedCulturesResourceKeyPager(int(req.Index), int(req.Size))
+		if err != nil {
+			return &proto.ResourceTrashReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+		}
+		for _, v := range keys {
+			data = append(data, trashItem(int64(v.ID), v.Name, v.TypeID, v.DeletedAt))
+		}
+		total = count
+	case repository.HistoryEntityType:
+		types, count, err := repo.GetDeletedCulturesResourceTypePager(int(req.Index), int(req.Size))
+		if err != nil {
+			return &proto.ResourceTrashReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+		}
+		for _, v := range types {
+			data = append(data, trashItem(int64(v.ID), v.Name, 0, v.DeletedAt))
+		}
+		total = count
+	default:
+		return &proto.ResourceTrashReply{Message: "not support entity " + req.Entity, Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	return &proto.ResourceTrashReply{Items: data, Total: total, Code: proto.ReplyCode_Success}, nil
+}
+
+// RestoreResource 从回收站还原资源键或资源类型。
+// 还原资源键时一并还原其所有语言翻译；已存在同名的资源键或资源类型时拒绝还原。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含实体类型（key/type）和ID的请求对象。
+//
+// 返回值:
+//
+//	*proto.CultureBaseReply - 操作结果。
+//	error - 错误对象。
+func (c *CulturesRpc) RestoreResource(ctx context.Context, req *proto.RestoreResourceRequest) (*proto.CultureBaseReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.CultureBaseReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	if req.Id <= 0 {
+		return &proto.CultureBaseReply{Message: "param data is null", Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	switch req.Entity {
+	case repository.HistoryEntityKey:
+		err = repo.RestoreCulturesResourceKey(actorContext(ctx), int32(req.Id))
+	case repository.HistoryEntityType:
+		err = repo.RestoreCulturesResourceType(actorContext(ctx), req.Id)
+	default:
+		return &proto.CultureBaseReply{Message: "not support entity " + req.Entity, Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	if err != nil {
+		return &proto.CultureBaseReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+	return &proto.CultureBaseReply{Code: proto.ReplyCode_Success, Message: "ok"}, nil
+}
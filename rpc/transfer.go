@@ -0,0 +1,516 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"i18n-service/data/entity"
+	"i18n-service/data/repository"
+	"i18n-service/formats"
+	"i18n-service/proto"
+)
+
+// errCultureNotExists 导入导出的语言不存在或未在项目中启用
+var errCultureNotExists = errors.New("culture not exists")
+
+// transferReplyCode 导入导出失败时的响应码
+func transferReplyCode(err error) proto.ReplyCode {
+	switch {
+	case errors.Is(err, errCultureNotExists):
+		return proto.ReplyCode_NotFound
+	case errors.Is(err, repository.ErrTypeNotFound):
+		return proto.ReplyCode_InvalidParam
+	case errors.Is(err, formats.ErrSyntax), errors.Is(err, repository.ErrTextTooLong), errors.Is(err, repository.ErrPlaceholderMismatch):
+		return proto.ReplyCode_InvalidData
+	}
+	return proto.ReplyCode_DataBaseError
+}
+
+// isTemplate 是否为只包含资源键的模板格式
+func isTemplate(format proto.ResourceFormats) bool {
+	return format == proto.ResourceFormats_Pot
+}
+
+// isBilingual 是否为包含原文的双语格式，导入时只更新已有资源键的翻译，并检查原文是否已变化
+func isBilingual(format proto.ResourceFormats) bool {
+	return format == proto.ResourceFormats_Xliff12 || format == proto.ResourceFormats_Xliff20
+}
+
+// goI18nEncoding go-i18n 消息文件的编码，不是 go-i18n 格式时 ok 为 false
+func goI18nEncoding(format proto.ResourceFormats) (formats.GoI18nEncoding, bool) {
+	switch format {
+	case proto.ResourceFormats_GoI18nToml:
+		return formats.GoI18nTOML, true
+	case proto.ResourceFormats_GoI18nJson:
+		return formats.GoI18nJSON, true
+	}
+	return 0, false
+}
+
+// encodeCatalog 按格式写出语言目录，返回文件内容、文件名和 MIME 类型
+func encodeCatalog(format proto.ResourceFormats, catalog *formats.Catalog) ([]byte, string, string, error) {
+	var buf bytes.Buffer
+	switch format {
+	case proto.ResourceFormats_Po:
+		if err := formats.WritePO(&buf, catalog, false); err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), catalog.Culture + ".po", "text/x-gettext-translation", nil
+	case proto.ResourceFormats_Pot:
+		if err := formats.WritePO(&buf, catalog, true); err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), "messages.pot", "text/x-gettext-translation-template", nil
+	case proto.ResourceFormats_Xliff12:
+		if err := formats.WriteXLIFF12(&buf, catalog); err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), catalog.Culture + ".xlf", "application/xliff+xml", nil
+	case proto.ResourceFormats_Xliff20:
+		if err := formats.WriteXLIFF20(&buf, catalog); err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), catalog.Culture + ".xlf", "application/xliff+xml", nil
+	case proto.ResourceFormats_GoI18nToml:
+		if err := formats.WriteGoI18n(&buf, catalog, formats.GoI18nTOML); err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), "active." + catalog.Culture + ".toml", "application/toml", nil
+	case proto.ResourceFormats_GoI18nJson:
+		if err := formats.WriteGoI18n(&buf, catalog, formats.GoI18nJSON); err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), "active." + catalog.Culture + ".json", "application/json", nil
+	case proto.ResourceFormats_Android:
+		if err := formats.WriteAndroid(&buf, catalog); err != nil {
+			return nil, "", "", err
+		}
+		// 默认语言的资源放在 values 目录，作为没有对应语言资源时的回退
+		dir := formats.AndroidValuesDir(catalog.Culture)
+		if catalog.Culture == catalog.SourceCulture {
+			dir = "values"
+		}
+		return buf.Bytes(), dir + "/strings.xml", "application/xml", nil
+	case proto.ResourceFormats_AppleStrings:
+		if err := formats.WriteAppleStrings(&buf, catalog); err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), catalog.Culture + ".lproj/Localizable.strings", "text/plain", nil
+	case proto.ResourceFormats_AppleStringsdict:
+		if err := formats.WriteAppleStringsdict(&buf, catalog); err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), catalog.Culture + ".lproj/Localizable.stringsdict", "application/x-plist", nil
+	case proto.ResourceFormats_Resx:
+		name := resxBaseName(catalog)
+		if err := formats.WriteRESX(&buf, catalog); err != nil {
+			return nil, "", "", err
+		}
+		// 默认语言为非特定语言资源，其他语言为附属资源
+		if catalog.Culture != catalog.SourceCulture {
+			name += "." + catalog.Culture
+		}
+		return buf.Bytes(), name + ".resx", "text/microsoft-resx", nil
+	}
+	return nil, "", "", fmt.Errorf("unsupported format %s", format)
+}
+
+// resxBaseName RESX 文件按资源类型导出，文件名为资源类型名称，资源类型没有资源键时为 Resources
+func resxBaseName(catalog *formats.Catalog) string {
+	for _, e := range catalog.Entries {
+		if e.Type != "" {
+			return e.Type
+		}
+	}
+	return "Resources"
+}
+
+// decodeCatalog 按格式解析文件，pluralLocale 为复数形式使用的语言，为空时使用文件中的语言
+func decodeCatalog(format proto.ResourceFormats, content []byte, pluralLocale string) (*formats.Catalog, error) {
+	switch format {
+	case proto.ResourceFormats_Po, proto.ResourceFormats_Pot:
+		return formats.ParsePO(bytes.NewReader(content), pluralLocale)
+	case proto.ResourceFormats_Xliff12, proto.ResourceFormats_Xliff20:
+		// 复数形式由单元 ID 中的复数类别确定
+		return formats.ParseXLIFF(bytes.NewReader(content))
+	case proto.ResourceFormats_GoI18nToml, proto.ResourceFormats_GoI18nJson:
+		encoding, _ := goI18nEncoding(format)
+		return formats.ParseGoI18n(bytes.NewReader(content), encoding)
+	case proto.ResourceFormats_Android:
+		return formats.ParseAndroid(bytes.NewReader(content))
+	case proto.ResourceFormats_AppleStrings:
+		return formats.ParseAppleStrings(bytes.NewReader(content))
+	case proto.ResourceFormats_AppleStringsdict:
+		return formats.ParseAppleStringsdict(bytes.NewReader(content))
+	case proto.ResourceFormats_Resx:
+		return formats.ParseRESX(bytes.NewReader(content))
+	}
+	return nil, fmt.Errorf("unsupported format %s", format)
+}
+
+// findCulture 在项目启用的语言中查找语言代码，code 为空时返回默认语言
+func findCulture(cultures []entity.CulturesResources, code string) (entity.CulturesResources, error) {
+	for _, v := range cultures {
+		if (code == "" && v.IsDefault) || (code != "" && v.Code == code) {
+			return v, nil
+		}
+	}
+	if code == "" {
+		return entity.CulturesResources{}, fmt.Errorf("%w: default culture", errCultureNotExists)
+	}
+	return entity.CulturesResources{}, fmt.Errorf("%w: %s", errCultureNotExists, code)
+}
+
+// exportCatalog 构建语言的资源目录，包含所有符合条件的资源键，未翻译的资源键文本为空，原文为默认语言的翻译。
+// 语言或默认语言有复数形式的资源键视为复数资源，未审核通过的翻译标记为待定。template 为 true 时只包含资源键。
+func exportCatalog(repo repository.CulturesRepository, code string, typeIds []int32, template bool) (*formats.Catalog, error) {
+	cultures, err := repo.GetCultures()
+	if err != nil {
+		return nil, err
+	}
+	reference, err := findCulture(cultures, "")
+	if err != nil {
+		return nil, err
+	}
+	culture := reference
+	if !template {
+		if culture, err = findCulture(cultures, code); err != nil {
+			return nil, err
+		}
+	}
+	catalog := &formats.Catalog{SourceCulture: reference.Code, PluralLocale: repository.PluralLocale(culture)}
+	if !template {
+		catalog.Culture = culture.Code
+	}
+	keys, err := repo.GetCulturesResourceKeyList(repository.ResourceKeyFilter{TypeIDs: typeIds})
+	if err != nil || len(keys) == 0 {
+		return catalog, err
+	}
+	keyIds := make([]int32, 0, len(keys))
+	typeIdSet := make(map[int32]bool)
+	var typeList []int32
+	for _, v := range keys {
+		keyIds = append(keyIds, v.ID)
+		if !typeIdSet[v.TypeID] {
+			typeIdSet[v.TypeID] = true
+			typeList = append(typeList, v.TypeID)
+		}
+	}
+	types, err := repo.GetCulturesResourceTypeByIds(typeList)
+	if err != nil {
+		return nil, err
+	}
+	typeNames := make(map[int32]string, len(types))
+	for _, v := range types {
+		typeNames[v.ID] = v.Name
+	}
+	cultureIds := []int32{culture.ID}
+	if reference.ID != culture.ID {
+		cultureIds = append(cultureIds, reference.ID)
+	}
+	langs, err := repo.GetCulturesResourceLangsByKeyIds(cultureIds, keyIds)
+	if err != nil {
+		return nil, err
+	}
+	texts := make(map[int32]entity.CulturesResourceLangs, len(langs))
+	sources := make(map[int32]string, len(langs))
+	for _, v := range langs {
+		if v.CultureID == culture.ID {
+			texts[v.KeyID] = v
+		}
+		if v.CultureID == reference.ID {
+			sources[v.KeyID] = v.Text
+		}
+	}
+	plurals, err := repo.GetCulturesResourcePluralsByKeyIds(cultureIds, keyIds)
+	if err != nil {
+		return nil, err
+	}
+	forms := make(map[int32]map[string]string)
+	sourceForms := make(map[int32]map[string]string)
+	pluralKeys := make(map[int32]bool)
+	for _, v := range plurals {
+		pluralKeys[v.KeyID] = true
+		if v.CultureID == culture.ID {
+			addPluralForm(forms, v)
+		}
+		if v.CultureID == reference.ID {
+			addPluralForm(sourceForms, v)
+		}
+	}
+	for _, key := range keys {
+		e := formats.Entry{
+			Key:           key.Name,
+			Type:          typeNames[key.TypeID],
+			Description:   key.Description,
+			Context:       key.Context,
+			MaxLength:     key.MaxLength,
+			Plural:        pluralKeys[key.ID],
+			Source:        sources[key.ID],
+			SourcePlurals: sourceForms[key.ID],
+		}
+		if lang, ok := texts[key.ID]; ok && !template {
+			e.Text = lang.Text
+			e.Plurals = forms[key.ID]
+			e.Fuzzy = lang.Status != int32(proto.TranslationStatuses_Approved)
+		}
+		catalog.Entries = append(catalog.Entries, e)
+	}
+	return catalog, nil
+}
+
+// addPluralForm 按资源键收集复数形式
+func addPluralForm(forms map[int32]map[string]string, v entity.CulturesResourcePlurals) {
+	if forms[v.KeyID] == nil {
+		forms[v.KeyID] = make(map[string]string)
+	}
+	forms[v.KeyID][v.Category] = v.Text
+}
+
+// reviewSources 检查双语文件中的资源项：不存在的资源键不导入，原文与默认语言当前的翻译不一致的资源项标记为待定，
+// 返回保留的资源项和需要关注的问题
+func reviewSources(repo repository.CulturesRepository, cultures []entity.CulturesResources, entries []formats.Entry) ([]formats.Entry, []*proto.ImportIssue, error) {
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Key)
+	}
+	keys, err := repo.GetCulturesResourceKeysByNames(names)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyIds := make(map[string]int32, len(keys))
+	ids := make([]int32, 0, len(keys))
+	for id, name := range keys {
+		keyIds[name] = id
+		ids = append(ids, id)
+	}
+	reference, err := findCulture(cultures, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	langs, err := repo.GetCulturesResourceLangsByKeyIds([]int32{reference.ID}, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	sources := make(map[int32]string, len(langs))
+	for _, v := range langs {
+		sources[v.KeyID] = v.Text
+	}
+	kept := make([]formats.Entry, 0, len(entries))
+	var issues []*proto.ImportIssue
+	for _, e := range entries {
+		id, ok := keyIds[e.Key]
+		if !ok {
+			issues = append(issues, &proto.ImportIssue{Key: e.Key, Type: proto.ImportIssueTypes_UnknownKey, Source: e.Source})
+			continue
+		}
+		// 复数资源的原文为 other 形式，默认语言的翻译文本即为其 other 形式
+		if current := sources[id]; e.Source != current {
+			issues = append(issues, &proto.ImportIssue{Key: e.Key, Type: proto.ImportIssueTypes_SourceChanged, Source: e.Source, CurrentSource: current})
+			e.Fuzzy = true
+		}
+		kept = append(kept, e)
+	}
+	return kept, issues, nil
+}
+
+// reviewHashes 检查 go-i18n 消息的哈希：已有资源键的哈希与当前描述和默认语言翻译计算的哈希不一致时，
+// 翻译所依据的原文已变化，资源项标记为待定并导入为草稿。没有哈希的消息及新资源键不检查。
+func reviewHashes(repo repository.CulturesRepository, cultures []entity.CulturesResources, entries []formats.Entry) ([]*proto.ImportIssue, error) {
+	keys, err := repo.GetCulturesResourceKeyList(repository.ResourceKeyFilter{})
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]entity.CulturesResourceKeys, len(keys))
+	ids := make([]int32, 0, len(keys))
+	for _, v := range keys {
+		byName[v.Name] = v
+		ids = append(ids, v.ID)
+	}
+	reference, err := findCulture(cultures, "")
+	if err != nil {
+		return nil, err
+	}
+	langs, err := repo.GetCulturesResourceLangsByKeyIds([]int32{reference.ID}, ids)
+	if err != nil {
+		return nil, err
+	}
+	sources := make(map[int32]string, len(langs))
+	for _, v := range langs {
+		sources[v.KeyID] = v.Text
+	}
+	var issues []*proto.ImportIssue
+	for i, e := range entries {
+		key, ok := byName[e.Key]
+		if e.Hash == "" || !ok {
+			continue
+		}
+		current := sources[key.ID]
+		if e.Hash != formats.GoI18nHash(key.Description, current) {
+			issues = append(issues, &proto.ImportIssue{Key: e.Key, Type: proto.ImportIssueTypes_SourceChanged, CurrentSource: current})
+			entries[i].Fuzzy = true
+		}
+	}
+	return issues, nil
+}
+
+// ExportResources 将语言的资源导出为本地化文件。
+// 文件包含符合条件的所有资源键，未翻译的资源键文本为空，未审核通过的翻译标记为待定；模板格式只包含资源键。
+// go-i18n、Android、iOS 及 RESX 格式在运行时回退到默认语言，只包含已审核通过的翻译；RESX 格式必须且只能指定一个资源类型。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含语言代码、文件格式和资源类型过滤条件的请求对象。
+//
+// 返回值:
+//
+//	*proto.ExportResourcesReply - 包含文件内容、建议的文件名和 MIME 类型的响应对象。
+//	error - 错误对象。
+func (c *CulturesRpc) ExportResources(ctx context.Context, req *proto.ExportResourcesRequest) (*proto.ExportResourcesReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.ExportResourcesReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	if req.Code == "" && !isTemplate(req.Format) {
+		return &proto.ExportResourcesReply{Message: "code is empty", Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	// RESX 文件按资源类型导出，每个文件只能包含一个资源类型
+	if req.Format == proto.ResourceFormats_Resx && len(req.TypeIds) != 1 {
+		return &proto.ExportResourcesReply{Message: "resx export requires exactly one type in type_ids", Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	catalog, err := exportCatalog(repo, req.Code, req.TypeIds, isTemplate(req.Format))
+	if err != nil {
+		return &proto.ExportResourcesReply{Message: err.Error(), Code: transferReplyCode(err)}, nil
+	}
+	content, fileName, contentType, err := encodeCatalog(req.Format, catalog)
+	if err != nil {
+		return &proto.ExportResourcesReply{Message: err.Error(), Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	return &proto.ExportResourcesReply{
+		Content:     content,
+		FileName:    fileName,
+		ContentType: contentType,
+		Code:        proto.ReplyCode_Success,
+		Message:     "ok",
+	}, nil
+}
+
+// ImportResources 导入本地化文件中的资源键和翻译。
+// 资源键按名称新增或更新，文件中的资源类型不存在时自动创建；翻译按资源键和语言新增或更新，
+// 未翻译的资源项只导入资源键，PO 文件中 fuzzy 的翻译导入为草稿。所有资源项在同一事务中导入，任一资源项校验失败时不做任何修改。
+// XLIFF 等双语格式只更新已有资源键的翻译，不存在的资源键及原文已变化的资源项在 issues 中返回，
+// 原文已变化及未审核通过的资源项导入为草稿。
+// go-i18n 消息文件中哈希与当前原文不一致的翻译在 issues 中返回，并导入为草稿。
+// 导入的翻译只能为草稿或待审核，新增或修改的翻译清除审核人。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的数据、取消信号等。
+//	req - 包含语言代码、文件格式、文件内容、默认资源类型和翻译审核状态的请求对象。
+//
+// 返回值:
+//
+//	*proto.ImportResourcesReply - 包含新增、修改及跳过数量的响应对象。
+//	error - 错误对象。
+func (c *CulturesRpc) ImportResources(ctx context.Context, req *proto.ImportResourcesRequest) (*proto.ImportResourcesReply, error) {
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.ImportResourcesReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	if len(req.Content) == 0 {
+		return &proto.ImportResourcesReply{Message: "content is empty", Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	// 导入的翻译需要审核，审核通过或驳回只能通过 TransitionResourceLangs
+	if req.Status != proto.TranslationStatuses_Draft && req.Status != proto.TranslationStatuses_NeedsReview {
+		return &proto.ImportResourcesReply{Message: "imported translations can only be draft or needs review", Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	cultures, err := repo.GetCultures()
+	if err != nil {
+		return &proto.ImportResourcesReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+	template := isTemplate(req.Format)
+	var culture entity.CulturesResources
+	locale := ""
+	if req.Code != "" && !template {
+		if culture, err = findCulture(cultures, req.Code); err != nil {
+			return &proto.ImportResourcesReply{Message: err.Error(), Code: transferReplyCode(err)}, nil
+		}
+		locale = repository.PluralLocale(culture)
+	}
+	catalog, err := decodeCatalog(req.Format, req.Content, locale)
+	if err != nil {
+		return &proto.ImportResourcesReply{Message: err.Error(), Code: transferReplyCode(err)}, nil
+	}
+	if req.Code == "" && !template {
+		// 使用文件中的语言，语言配置了复数规则时按该规则重新解析复数形式
+		if catalog.Culture == "" {
+			return &proto.ImportResourcesReply{Message: "code is empty and file has no language", Code: proto.ReplyCode_InvalidParam}, nil
+		}
+		if culture, err = findCulture(cultures, catalog.Culture); err != nil {
+			return &proto.ImportResourcesReply{Message: err.Error(), Code: transferReplyCode(err)}, nil
+		}
+		if locale = repository.PluralLocale(culture); locale != catalog.Culture {
+			if catalog, err = decodeCatalog(req.Format, req.Content, locale); err != nil {
+				return &proto.ImportResourcesReply{Message: err.Error(), Code: transferReplyCode(err)}, nil
+			}
+		}
+	}
+	var issues []*proto.ImportIssue
+	if isBilingual(req.Format) {
+		if catalog.Entries, issues, err = reviewSources(repo, cultures, catalog.Entries); err != nil {
+			return &proto.ImportResourcesReply{Message: err.Error(), Code: transferReplyCode(err)}, nil
+		}
+	}
+	if _, ok := goI18nEncoding(req.Format); ok && !culture.IsDefault {
+		if issues, err = reviewHashes(repo, cultures, catalog.Entries); err != nil {
+			return &proto.ImportResourcesReply{Message: err.Error(), Code: transferReplyCode(err)}, nil
+		}
+	}
+	entries, values := importEntries(catalog, culture.ID, req.Status, template)
+	if err := validatePlurals(cultures, values); err != nil {
+		return &proto.ImportResourcesReply{Message: err.Error(), Code: proto.ReplyCode_InvalidData}, nil
+	}
+	result, err := repo.ImportCulturesResources(actorContext(ctx), culture.ID, req.TypeId, entries)
+	if err != nil {
+		return &proto.ImportResourcesReply{Message: err.Error(), Code: transferReplyCode(err)}, nil
+	}
+	return &proto.ImportResourcesReply{
+		KeysAdded:    int32(result.KeysAdded),
+		KeysUpdated:  int32(result.KeysUpdated),
+		LangsAdded:   int32(result.LangsAdded),
+		LangsUpdated: int32(result.LangsUpdated),
+		Skipped:      int32(result.Skipped),
+		Issues:       issues,
+		Code:         proto.ReplyCode_Success,
+		Message:      "ok",
+	}, nil
+}
+
+// importEntries 将语言目录转换为导入的资源项，同时返回用于校验复数形式的翻译。
+// 待定的翻译（PO 的 fuzzy、XLIFF 未审核通过的状态、原文已变化的资源项）导入为草稿，其他翻译使用请求的审核状态。
+// 模板及未翻译的资源项只导入资源键。
+func importEntries(catalog *formats.Catalog, cultureID int32, status proto.TranslationStatuses, template bool) ([]repository.ImportEntry, []*proto.CultureKeyValue) {
+	entries := make([]repository.ImportEntry, 0, len(catalog.Entries))
+	var values []*proto.CultureKeyValue
+	for _, e := range catalog.Entries {
+		entry := repository.ImportEntry{
+			Key: entity.CulturesResourceKeys{
+				Name:        e.Key,
+				Description: e.Description,
+				Context:     e.Context,
+				MaxLength:   e.MaxLength,
+			},
+			Type: e.Type,
+		}
+		if !template && (e.Text != "" || len(e.Plurals) > 0) {
+			s := status
+			if e.Fuzzy {
+				s = proto.TranslationStatuses_Draft
+			}
+			entry.Lang = &entity.CulturesResourceLangs{Text: e.Text, Plurals: e.Plurals, Status: int32(s)}
+			values = append(values, &proto.CultureKeyValue{CultureId: cultureID, Text: e.Text, Plurals: e.Plurals})
+		}
+		entries = append(entries, entry)
+	}
+	return entries, values
+}
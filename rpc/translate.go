@@ -0,0 +1,155 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"i18n-service/data/repository"
+	"i18n-service/msgformat"
+	"i18n-service/proto"
+)
+
+// Translate 批量翻译指定的资源键。
+// 该方法只按名称查询请求的资源键及其在回退链上的翻译，不加载全部资源键。
+// 参数:
+//
+//	ctx - 上下文，用于传递请求范围的 deadline、取消信号等。
+//	req - 包含语言代码和资源键列表的请求对象，语言代码为空时根据 accept-language 元数据协商语言；
+//	      render 为 true 时按 ICU MessageFormat 使用请求参数渲染文本。
+//
+// 返回值:
+//
+//	*proto.TranslateReply - 包含翻译结果、来源语言、不存在的资源键和渲染错误的响应对象。
+//	error - 错误对象。
+func (c *CulturesRpc) Translate(ctx context.Context, req *proto.TranslateRequest) (*proto.TranslateReply, error) {
+	if len(req.Keys) == 0 {
+		return &proto.TranslateReply{Message: "param data is null", Code: proto.ReplyCode_InvalidParam}, nil
+	}
+	repo, err := c.projectRepo(ctx, req.Project)
+	if err != nil {
+		return &proto.TranslateReply{Message: err.Error(), Code: projectReplyCode(err)}, nil
+	}
+	code, err := negotiateCulture(ctx, repo, req.Code)
+	if err != nil {
+		return &proto.TranslateReply{Message: err.Error(), Code: proto.ReplyCode_DataBaseError}, nil
+	}
+	snapshots, keyIds, err := translateSnapshots(repo, code, req.Keys)
+	if err != nil {
+		return &proto.TranslateReply{Message: err.Error(), Code: snapshotReplyCode(err)}, nil
+	}
+	reply := &proto.TranslateReply{Code: proto.ReplyCode_Success, Message: "ok", Culture: snapshots[0].Culture.Code}
+	for _, name := range req.Keys {
+		id, ok := keyIds[name]
+		if !ok {
+			reply.UnknownKeys = append(reply.UnknownKeys, name)
+			continue
+		}
+		reply.Items = append(reply.Items, cultureResourceItem(snapshots, id, name, false))
+	}
+	if req.Render {
+		renderItems(reply, snapshots, messageArguments(req.Arguments))
+	}
+	return reply, nil
+}
+
+// renderItems 按 ICU MessageFormat 渲染翻译结果，使用文本来源语言配置的复数规则和数字格式。
+// 渲染失败的资源项保留原文，错误记录在 errors 中。
+func renderItems(reply *proto.TranslateReply, snapshots []*repository.CultureSnapshot, args map[string]interface{}) {
+	locales := make(map[string]string, len(snapshots))
+	for _, snapshot := range snapshots {
+		locales[snapshot.Culture.Code] = repository.PluralLocale(snapshot.Culture)
+	}
+	for _, item := range reply.Items {
+		locale := item.Culture
+		if locale == "" {
+			locale = reply.Culture
+		}
+		locale = locales[locale]
+		text, err := msgformat.Format(locale, item.Text, args)
+		if err != nil {
+			if reply.Errors == nil {
+				reply.Errors = make(map[string]string)
+			}
+			reply.Errors[item.Key] = err.Error()
+			continue
+		}
+		item.Text = text
+	}
+	if len(reply.Errors) > 0 {
+		reply.Code = proto.ReplyCode_InvalidData
+		reply.Message = fmt.Sprintf("render failed for %d keys", len(reply.Errors))
+	}
+}
+
+// messageArguments 将请求中的渲染参数转换为格式化参数
+func messageArguments(arguments map[string]*proto.MessageArgument) map[string]interface{} {
+	args := make(map[string]interface{}, len(arguments))
+	for name, arg := range arguments {
+		switch v := arg.GetValue().(type) {
+		case *proto.MessageArgument_StringValue:
+			args[name] = v.StringValue
+		case *proto.MessageArgument_IntValue:
+			args[name] = v.IntValue
+		case *proto.MessageArgument_FloatValue:
+			args[name] = v.FloatValue
+		case *proto.MessageArgument_DateValue:
+			args[name] = v.DateValue.AsTime()
+		}
+	}
+	return args
+}
+
+// translateSnapshots 按资源键名称查询回退链上的翻译，构建只包含这些资源键的快照。
+// 返回回退链快照和资源键名称到ID的映射。
+func translateSnapshots(repo repository.CulturesRepository, code string, names []string) ([]*repository.CultureSnapshot, map[string]int32, error) {
+	chain, err := repo.GetCultureFallbackChain(code)
+	if err != nil {
+		return nil, nil, err
+	}
+	if chain, err = ServedChain(chain, false); err != nil {
+		return nil, nil, err
+	}
+	keys, err := repo.GetCulturesResourceKeysByNames(names)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyIds := make(map[string]int32, len(keys))
+	ids := make([]int32, 0, len(keys))
+	for id, name := range keys {
+		keyIds[name] = id
+		ids = append(ids, id)
+	}
+	cultureIds := make([]int32, 0, len(chain))
+	snapshots := make([]*repository.CultureSnapshot, 0, len(chain))
+	byCulture := make(map[int32]*repository.CultureSnapshot, len(chain))
+	for _, culture := range chain {
+		snapshot := &repository.CultureSnapshot{
+			Culture:  culture,
+			Texts:    make(map[int32]string),
+			Plurals:  make(map[int32]map[string]string),
+			Statuses: make(map[int32]int32),
+		}
+		cultureIds = append(cultureIds, culture.ID)
+		snapshots = append(snapshots, snapshot)
+		byCulture[culture.ID] = snapshot
+	}
+	langs, err := repo.GetCulturesResourceLangsByKeyIds(cultureIds, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, v := range langs {
+		byCulture[v.CultureID].Texts[v.KeyID] = v.Text
+		byCulture[v.CultureID].Statuses[v.KeyID] = v.Status
+	}
+	plurals, err := repo.GetCulturesResourcePluralsByKeyIds(cultureIds, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, v := range plurals {
+		snapshot := byCulture[v.CultureID]
+		if snapshot.Plurals[v.KeyID] == nil {
+			snapshot.Plurals[v.KeyID] = make(map[string]string)
+		}
+		snapshot.Plurals[v.KeyID][v.Category] = v.Text
+	}
+	return snapshots, keyIds, nil
+}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"i18n-service/proto"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// BuildResourceTree 将点分隔的资源键构建为嵌套结构，例如 home.title 构建为 {"home": {"title": "..."}}。
+// 资源键按名称排序后依次插入，一个键既是叶子又是其它键的前缀时（如 a.b 与 a.b.c），
+// 保留叶子并将冲突的键记录到返回的冲突列表中。
+func BuildResourceTree(items []*proto.CultureResourceItem) (*structpb.Struct, []string, error) {
+	sorted := make([]*proto.CultureResourceItem, len(items))
+	copy(sorted, items)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key < sorted[j].Key })
+
+	root := make(map[string]interface{})
+	var conflicts []string
+	for _, item := range sorted {
+		if !insertTreeNode(root, item.Key, item.Text) {
+			conflicts = append(conflicts, item.Key)
+		}
+	}
+	tree, err := structpb.NewStruct(root)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tree, conflicts, nil
+}
+
+// insertTreeNode 将资源键插入资源树，键包含空段或与已有的键冲突时不插入并返回 false
+func insertTreeNode(root map[string]interface{}, key string, text string) bool {
+	segments := strings.Split(key, ".")
+	// 先检查空段，避免插入失败时留下空的中间节点
+	for _, segment := range segments {
+		if segment == "" {
+			return false
+		}
+	}
+	node := root
+	for i, segment := range segments {
+		if i == len(segments)-1 {
+			if _, ok := node[segment].(map[string]interface{}); ok {
+				return false
+			}
+			node[segment] = text
+			return true
+		}
+		switch child := node[segment].(type) {
+		case nil:
+			next := make(map[string]interface{})
+			node[segment] = next
+			node = next
+		case map[string]interface{}:
+			node = child
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,198 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"i18n-service/data/repository"
+	"i18n-service/proto"
+	"strconv"
+	"strings"
+)
+
+// errWatchClosed 已向客户端发送错误事件，订阅流应正常结束
+var errWatchClosed = errors.New("watch closed")
+
+// resourceWatcher 单个语言资源订阅流的状态
+type resourceWatcher struct {
+	repo   repository.CulturesRepository
+	stream proto.I18NService_WatchCultureResourcesServer
+	code   string
+	epoch  int64
+	seq    uint64 // 已推送到的变更序号
+}
+
+// WatchCultureResources 订阅特定语言的翻译资源变更。
+// 该方法先推送全量快照，之后在资源键或翻译发生变更时推送增量事件。
+// 语言代码为空时根据 accept-language 元数据协商语言。
+// 请求携带有效的续传令牌时，只补发断线期间错过的变更；令牌失效时重新推送全量快照。
+// 变更流只包含本实例写入的变更：多副本部署时其他副本的修改不会推送，
+// 续传令牌只对签发它的实例在重启前有效，重连到其他实例或实例重启后都会重新推送全量快照。
+// 参数:
+//
+//	req - 包含语言代码和续传令牌的请求对象。
+//	stream - 服务端推送流。
+//
+// 返回值:
+//
+//	error - 推送失败时返回的错误。
+func (c *CulturesRpc) WatchCultureResources(req *proto.WatchCultureRequest, stream proto.I18NService_WatchCultureResourcesServer) error {
+	// 变更流按项目隔离，只会收到该项目的变更。
+	repo, err := c.projectRepo(stream.Context(), req.Project)
+	if err != nil {
+		return stream.Send(&proto.CultureResourceEvent{Message: err.Error(), Code: projectReplyCode(err)})
+	}
+	// 未指定语言代码时，根据 accept-language 元数据协商语言。
+	code, err := negotiateCulture(stream.Context(), repo, req.Code)
+	if err != nil {
+		return stream.Send(&proto.CultureResourceEvent{Message: err.Error(), Code: proto.ReplyCode_DataBaseError})
+	}
+	w := &resourceWatcher{repo: repo, stream: stream, code: code}
+	epoch, seq, resume := parseResumeToken(req.ResumeToken)
+	for {
+		sub := repo.SubscribeResourceChanges()
+		err := w.sync(sub, resume && epoch == sub.Epoch, seq)
+		if err == nil {
+			err = w.forward(sub)
+		}
+		sub.Close()
+		if errors.Is(err, errWatchClosed) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		default:
+		}
+		// 订阅因消费过慢被关闭，从已推送的位置续传
+		epoch, seq, resume = w.epoch, w.seq, true
+	}
+}
+
+// sync 补发 seq 之后到订阅时刻的变更，无法补发时推送全量快照
+func (w *resourceWatcher) sync(sub *repository.ChangeSubscription, resume bool, seq uint64) error {
+	w.epoch = sub.Epoch
+	if resume {
+		if changes, ok := w.repo.GetResourceChangesSince(seq); ok {
+			w.seq = seq
+			for _, change := range changes {
+				if change.Seq > sub.Seq {
+					break
+				}
+				if err := w.sendChange(change); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	return w.sendSnapshot(sub.Seq)
+}
+
+// forward 推送订阅到的实时变更，订阅被关闭时返回 nil
+func (w *resourceWatcher) forward(sub *repository.ChangeSubscription) error {
+	for {
+		select {
+		case <-w.stream.Context().Done():
+			return errWatchClosed
+		case change, ok := <-sub.C:
+			if !ok {
+				return nil
+			}
+			if err := w.sendChange(change); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendSnapshot 推送全量快照
+func (w *resourceWatcher) sendSnapshot(seq uint64) error {
+	snapshots, err := cultureSnapshots(w.repo, w.code, false)
+	if err != nil {
+		return w.fail(snapshotReplyCode(err), err)
+	}
+	keys, err := w.repo.GetCulturesResourceKeys()
+	if err != nil {
+		return w.fail(proto.ReplyCode_DataBaseError, err)
+	}
+	w.seq = seq
+	return w.stream.Send(&proto.CultureResourceEvent{
+		Type:        proto.ResourceEventTypes_Snapshot,
+		Items:       cultureResourceItems(snapshots, keys, false),
+		ResumeToken: resumeToken(w.epoch, seq),
+		Code:        proto.ReplyCode_Success,
+	})
+}
+
+// sendChange 将资源变更转换为订阅语言的增量事件并推送，与该语言回退链无关的变更会被跳过
+func (w *resourceWatcher) sendChange(change repository.ResourceChange) error {
+	if change.Type == repository.ChangeReset {
+		// 任意语言变更都可能影响回退链
+		return w.sendSnapshot(change.Seq)
+	}
+	snapshots, err := cultureSnapshots(w.repo, w.code, false)
+	if err != nil {
+		return w.fail(snapshotReplyCode(err), err)
+	}
+	if change.CultureID != 0 && !containsCulture(snapshots, change.CultureID) {
+		w.seq = change.Seq
+		return nil
+	}
+	event := &proto.CultureResourceEvent{ResumeToken: resumeToken(w.epoch, change.Seq), Code: proto.ReplyCode_Success}
+	switch change.Type {
+	case repository.ChangeDeleted:
+		event.Type = proto.ResourceEventTypes_Deleted
+		event.Items = []*proto.CultureResourceItem{{Key: change.Key}}
+	default:
+		keys, err := w.repo.GetCulturesResourceKeys()
+		if err != nil {
+			return w.fail(proto.ReplyCode_DataBaseError, err)
+		}
+		name, ok := keys[change.KeyID]
+		if !ok {
+			// 资源键已被删除，后续的删除事件会通知客户端
+			w.seq = change.Seq
+			return nil
+		}
+		event.Type = proto.ResourceEventTypes_Updated
+		if change.Type == repository.ChangeAdded && change.CultureID == 0 {
+			event.Type = proto.ResourceEventTypes_Added
+		}
+		event.Items = []*proto.CultureResourceItem{cultureResourceItem(snapshots, change.KeyID, name, false)}
+	}
+	w.seq = change.Seq
+	return w.stream.Send(event)
+}
+
+// fail 推送错误事件并结束订阅
+func (w *resourceWatcher) fail(code proto.ReplyCode, err error) error {
+	if ex := w.stream.Send(&proto.CultureResourceEvent{Message: err.Error(), Code: code}); ex != nil {
+		return ex
+	}
+	return errWatchClosed
+}
+
+// resumeToken 生成续传令牌，格式为 "纪元.序号"
+func resumeToken(epoch int64, seq uint64) string {
+	return fmt.Sprintf("%d.%d", epoch, seq)
+}
+
+// parseResumeToken 解析续传令牌
+func parseResumeToken(token string) (int64, uint64, bool) {
+	epochStr, seqStr, ok := strings.Cut(token, ".")
+	if !ok {
+		return 0, 0, false
+	}
+	epoch, err := strconv.ParseInt(epochStr, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err := strconv.ParseUint(seqStr, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return epoch, seq, true
+}
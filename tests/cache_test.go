@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"i18n-service/config"
+	"i18n-service/data/entity"
+	"i18n-service/data/repository"
+	"testing"
+)
+
+func newTestSnapshot(id int32, code string, texts map[int32]string) *repository.CultureSnapshot {
+	var langs []entity.CulturesResourceLangs
+	for keyID, text := range texts {
+		langs = append(langs, entity.CulturesResourceLangs{KeyID: keyID, CultureID: id, Text: text, Status: repository.StatusApproved})
+	}
+	return repository.NewCultureSnapshot(entity.CulturesResources{ID: id, Code: code}, langs, nil)
+}
+
+func TestResourceCache_GetCulture(t *testing.T) {
+	cache := repository.NewResourceCache(config.CacheConfig{TTL: 300})
+	cache.PutCulture(cache.CurrentGeneration(), newTestSnapshot(1, "en-US", map[int32]string{1: "Home"}))
+	// MySQL 不区分大小写匹配语言代码，不同大小写的请求命中同一个快照
+	tests := []struct {
+		code string
+		hit  bool
+	}{
+		{"en-US", true},
+		{"en-us", true},
+		{"EN-US", true},
+		{"en", false},
+		{"en_US", false},
+	}
+	for _, tt := range tests {
+		s, ok := cache.GetCulture(tt.code)
+		if ok != tt.hit {
+			t.Fatalf("GetCulture(%q) hit = %v, want %v", tt.code, ok, tt.hit)
+		}
+		if ok && s.Texts[1] != "Home" {
+			t.Fatalf("GetCulture(%q) texts = %v", tt.code, s.Texts)
+		}
+	}
+	if stats := cache.Stats(); stats.Hits != 3 || stats.Misses != 2 || stats.Entries != 1 {
+		t.Fatalf("Stats = %+v, want 3 hits, 2 misses, 1 entry", stats)
+	}
+}
+
+func TestResourceCache_Generation(t *testing.T) {
+	// 加载开始后发生变更时，加载的快照已过时，不能写入缓存
+	tests := []struct {
+		name   string
+		change func(cache *repository.ResourceCache)
+		cached bool
+	}{
+		{"no change", func(cache *repository.ResourceCache) {}, true},
+		{"text patched", func(cache *repository.ResourceCache) { cache.PatchText(1, 1, "Start", repository.StatusApproved, nil) }, false},
+		{"invalidated", func(cache *repository.ResourceCache) { cache.Invalidate() }, false},
+	}
+	for _, tt := range tests {
+		cache := repository.NewResourceCache(config.CacheConfig{TTL: 300})
+		generation := cache.CurrentGeneration()
+		tt.change(cache)
+		cache.PutCulture(generation, newTestSnapshot(1, "de", map[int32]string{1: "Home"}))
+		if _, ok := cache.GetCulture("de"); ok != tt.cached {
+			t.Fatalf("%s: cached = %v, want %v", tt.name, ok, tt.cached)
+		}
+	}
+}
+
+func TestResourceCache_PatchText(t *testing.T) {
+	cache := repository.NewResourceCache(config.CacheConfig{TTL: 300})
+	cache.PutCulture(cache.CurrentGeneration(), newTestSnapshot(1, "de", map[int32]string{1: "Home", 2: "Menu"}))
+	cache.PutCulture(cache.CurrentGeneration(), newTestSnapshot(2, "fr", map[int32]string{1: "Accueil"}))
+	before, _ := cache.GetCulture("de")
+
+	cache.PatchText(1, 1, "Startseite", repository.StatusApproved, nil)
+	after, _ := cache.GetCulture("de")
+	if after.Texts[1] != "Startseite" || after.Texts[2] != "Menu" {
+		t.Fatalf("patched texts = %v", after.Texts)
+	}
+	// 快照写时复制，已取得的快照不变
+	if before.Texts[1] != "Home" {
+		t.Fatalf("published snapshot modified: %v", before.Texts)
+	}
+	// 增量维护的版本与重新加载的快照一致
+	if want := newTestSnapshot(1, "de", map[int32]string{1: "Startseite", 2: "Menu"}).Version; after.Version != want || after.Version == before.Version {
+		t.Fatalf("patched version = %x, before %x, want %x", after.Version, before.Version, want)
+	}
+	if fr, _ := cache.GetCulture("fr"); fr.Texts[1] != "Accueil" {
+		t.Fatalf("other culture patched: %v", fr.Texts)
+	}
+}
+
+func TestResourceCache_Evict(t *testing.T) {
+	cache := repository.NewResourceCache(config.CacheConfig{TTL: 300, MaxEntries: 2})
+	cache.PutCulture(cache.CurrentGeneration(), newTestSnapshot(1, "de", nil))
+	cache.PutCulture(cache.CurrentGeneration(), newTestSnapshot(2, "fr", nil))
+	// 访问 de 后 fr 为最久未使用的快照
+	cache.GetCulture("de")
+	cache.PutCulture(cache.CurrentGeneration(), newTestSnapshot(3, "es", nil))
+	for code, want := range map[string]bool{"de": true, "fr": false, "es": true} {
+		if _, ok := cache.GetCulture(code); ok != want {
+			t.Fatalf("GetCulture(%q) hit = %v, want %v", code, ok, want)
+		}
+	}
+	if stats := cache.Stats(); stats.Evictions != 1 || stats.Entries != 2 {
+		t.Fatalf("Stats = %+v, want 1 eviction and 2 entries", stats)
+	}
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"errors"
+	"i18n-service/data/entity"
+	"i18n-service/data/repository"
+	"i18n-service/rpc"
+	"reflect"
+	"testing"
+)
+
+func TestServedChain(t *testing.T) {
+	enabled := entity.CulturesResources{ID: 1, Code: "pt-PT"}
+	beta := entity.CulturesResources{ID: 2, Code: "pt-BR", Status: repository.CultureBeta}
+	disabled := entity.CulturesResources{ID: 3, Code: "pt", Status: repository.CultureDisabled}
+	fallback := entity.CulturesResources{ID: 4, Code: "en", IsDefault: true}
+	tests := []struct {
+		name    string
+		chain   []entity.CulturesResources
+		preview bool
+		want    []string
+		err     error
+	}{
+		{"all served", []entity.CulturesResources{enabled, beta, fallback}, false, []string{"pt-PT", "pt-BR", "en"}, nil},
+		{"disabled parent skipped", []entity.CulturesResources{enabled, disabled, fallback}, false, []string{"pt-PT", "en"}, nil},
+		{"requested disabled", []entity.CulturesResources{disabled, fallback}, false, nil, rpc.ErrCultureDisabled},
+		{"preview keeps disabled", []entity.CulturesResources{disabled, fallback}, true, []string{"pt", "en"}, nil},
+	}
+	for _, tt := range tests {
+		chain, err := rpc.ServedChain(tt.chain, tt.preview)
+		if !errors.Is(err, tt.err) {
+			t.Fatalf("%s: err = %v, want %v", tt.name, err, tt.err)
+		}
+		var got []string
+		for _, v := range chain {
+			got = append(got, v.Code)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: chain = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCultureDirection(t *testing.T) {
+	tests := []struct {
+		culture entity.CulturesResources
+		want    string
+	}{
+		{entity.CulturesResources{Code: "en"}, "ltr"},
+		{entity.CulturesResources{Code: "ar"}, "rtl"},
+		{entity.CulturesResources{Code: "he-IL"}, "rtl"},
+		{entity.CulturesResources{Code: "ar", Direction: "ltr"}, "ltr"},
+	}
+	for _, tt := range tests {
+		if got := repository.CultureDirection(tt.culture); got != tt.want {
+			t.Fatalf("CultureDirection(%+v) = %s, want %s", tt.culture, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"i18n-service/data/repository"
+	"testing"
+)
+
+// 与 repository 中变更缓冲区及订阅通道的大小一致
+const (
+	changeBufferSize     = 4096
+	subscriberBufferSize = 256
+)
+
+func publishChanges(feed *repository.ChangeFeed, n int) {
+	for i := 0; i < n; i++ {
+		feed.Publish(repository.ResourceChange{Type: repository.ChangeUpdated, KeyID: int32(i + 1)})
+	}
+}
+
+func TestChangeFeed_Since(t *testing.T) {
+	feed := repository.NewChangeFeed()
+	// 缓冲区已循环覆盖最早的 10 个变更
+	publishChanges(feed, changeBufferSize+10)
+	last := uint64(changeBufferSize + 10)
+	tests := []struct {
+		name  string
+		seq   uint64
+		ok    bool
+		count int
+	}{
+		{"overwritten", 0, false, 0},
+		{"oldest overwritten", 9, false, 0},
+		{"oldest buffered", 10, true, changeBufferSize},
+		{"middle", last - 5, true, 5},
+		{"up to date", last, true, 0},
+		{"from the future", last + 1, false, 0},
+	}
+	for _, tt := range tests {
+		changes, ok := feed.Since(tt.seq)
+		if ok != tt.ok || len(changes) != tt.count {
+			t.Fatalf("%s: Since(%d) = %d changes, %v, want %d, %v", tt.name, tt.seq, len(changes), ok, tt.count, tt.ok)
+		}
+		for i, change := range changes {
+			if want := tt.seq + uint64(i) + 1; change.Seq != want || change.KeyID != int32(want) {
+				t.Fatalf("%s: change %d = %+v, want seq %d", tt.name, i, change, want)
+			}
+		}
+	}
+}
+
+func TestChangeFeed_Subscribe(t *testing.T) {
+	feed := repository.NewChangeFeed()
+	publishChanges(feed, 3)
+	sub := feed.Subscribe()
+	defer sub.Close()
+	if sub.Seq != 3 {
+		t.Fatalf("Subscribe seq = %d, want 3", sub.Seq)
+	}
+	publishChanges(feed, 1)
+	if change := <-sub.C; change.Seq != 4 {
+		t.Fatalf("received %+v, want seq 4", change)
+	}
+}
+
+func TestChangeFeed_SlowSubscriber(t *testing.T) {
+	feed := repository.NewChangeFeed()
+	slow := feed.Subscribe()
+	closed := feed.Subscribe()
+	closed.Close()
+	if _, ok := <-closed.C; ok {
+		t.Fatal("closed subscription still open")
+	}
+	// 通道写满后订阅被关闭，已缓冲的变更仍可读出，之后从缓冲区续传
+	publishChanges(feed, subscriberBufferSize+1)
+	received := 0
+	for range slow.C {
+		received++
+	}
+	if received != subscriberBufferSize {
+		t.Fatalf("slow subscriber received %d changes, want %d", received, subscriberBufferSize)
+	}
+	changes, ok := feed.Since(uint64(received))
+	if !ok || len(changes) != 1 || changes[0].Seq != subscriberBufferSize+1 {
+		t.Fatalf("Since(%d) = %+v, %v", received, changes, ok)
+	}
+	// 关闭后再取消订阅不会重复关闭通道
+	slow.Close()
+}
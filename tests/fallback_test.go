@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"i18n-service/data/entity"
+	"i18n-service/data/repository"
+	"reflect"
+	"testing"
+)
+
+func TestBuildFallbackChain(t *testing.T) {
+	cultures := []entity.CulturesResources{
+		{ID: 1, Code: "en", IsDefault: true},
+		{ID: 2, Code: "zh"},
+		{ID: 3, Code: "zh-Hant"},
+		{ID: 4, Code: "zh-Hant-TW"},
+		{ID: 5, Code: "zh-HK", ParentID: 3},
+		{ID: 6, Code: "pt-BR"},
+		{ID: 7, Code: "pt-PT", ParentID: 6},
+		{ID: 8, Code: "a", ParentID: 9},
+		{ID: 9, Code: "b", ParentID: 8},
+	}
+	tests := []struct {
+		code string
+		want []string
+	}{
+		{"en", []string{"en"}},
+		{"zh-Hant-TW", []string{"zh-Hant-TW", "zh-Hant", "zh", "en"}},
+		{"zh_hant_tw", []string{"zh-Hant-TW", "zh-Hant", "zh", "en"}},
+		// 显式配置的父语言优先于截断
+		{"zh-HK", []string{"zh-HK", "zh-Hant", "zh", "en"}},
+		{"pt-PT", []string{"pt-PT", "pt-BR", "en"}},
+		// 不存在的语言从最接近的已有语言开始
+		{"zh-Hant-MO", []string{"zh-Hant", "zh", "en"}},
+		{"pt", nil},
+		// 父语言循环时停止
+		{"a", []string{"a", "b", "en"}},
+	}
+	for _, tt := range tests {
+		chain, err := repository.BuildFallbackChain(cultures, tt.code)
+		if tt.want == nil {
+			if err == nil {
+				t.Fatalf("BuildFallbackChain(%q) = %v, want error", tt.code, chain)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("BuildFallbackChain(%q): %v", tt.code, err)
+		}
+		var got []string
+		for _, v := range chain {
+			got = append(got, v.Code)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("BuildFallbackChain(%q) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
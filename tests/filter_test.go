@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"i18n-service/data/repository"
+	"reflect"
+	"testing"
+)
+
+func TestResourceKeyFilter_NameCondition(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter repository.ResourceKeyFilter
+		where  string
+		args   []interface{}
+	}{
+		{"empty", repository.ResourceKeyFilter{}, "", nil},
+		{"types only", repository.ResourceKeyFilter{TypeIDs: []int32{1}, Tags: []string{"release"}}, "", nil},
+		{"prefix", repository.ResourceKeyFilter{Prefixes: []string{"mobile."}}, "(name LIKE ?)", []interface{}{"mobile.%"}},
+		{"prefix escaped", repository.ResourceKeyFilter{Prefixes: []string{`a_b%c\`}}, "(name LIKE ?)", []interface{}{`a\_b\%c\\%`}},
+		{"pattern", repository.ResourceKeyFilter{Patterns: []string{"*.title?"}}, "(name LIKE ?)", []interface{}{"%.title_"}},
+		{"pattern escaped", repository.ResourceKeyFilter{Patterns: []string{`home_*%`}}, "(name LIKE ?)", []interface{}{`home\_%\%`}},
+		{"prefixes and patterns", repository.ResourceKeyFilter{Prefixes: []string{"web.", "mobile."}, Patterns: []string{"*.error"}},
+			"(name LIKE ? OR name LIKE ? OR name LIKE ?)", []interface{}{"web.%", "mobile.%", "%.error"}},
+	}
+	for _, tt := range tests {
+		where, args := tt.filter.NameCondition()
+		if where != tt.where || !reflect.DeepEqual(args, tt.args) {
+			t.Fatalf("%s: NameCondition() = %q, %v, want %q, %v", tt.name, where, args, tt.where, tt.args)
+		}
+	}
+}
+
+func TestResourceKeyFilter_IsEmpty(t *testing.T) {
+	tests := []struct {
+		filter repository.ResourceKeyFilter
+		empty  bool
+	}{
+		{repository.ResourceKeyFilter{}, true},
+		{repository.ResourceKeyFilter{Prefixes: []string{}}, true},
+		{repository.ResourceKeyFilter{TypeIDs: []int32{1}}, false},
+		{repository.ResourceKeyFilter{TypeNames: []string{"web"}}, false},
+		{repository.ResourceKeyFilter{Prefixes: []string{"a."}}, false},
+		{repository.ResourceKeyFilter{Patterns: []string{"*"}}, false},
+		{repository.ResourceKeyFilter{Tags: []string{"release"}}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.IsEmpty(); got != tt.empty {
+			t.Fatalf("%+v IsEmpty() = %v, want %v", tt.filter, got, tt.empty)
+		}
+	}
+}
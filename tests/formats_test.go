@@ -0,0 +1,362 @@
+package tests
+
+import (
+	"bytes"
+	"i18n-service/formats"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPO_RoundTrip(t *testing.T) {
+	catalog := &formats.Catalog{Culture: "ru", Entries: []formats.Entry{
+		{Key: "home.title", Type: "web", Description: "Page title\nShown in tab", Context: "Home page", MaxLength: 20, Text: "Главная \"страница\"\nвторая строка", Fuzzy: true},
+		{Key: "files", Plural: true, Plurals: map[string]string{"one": "# файл", "few": "# файла", "many": "# файлов", "other": "# файла"}},
+		{Key: "empty"},
+	}}
+	var buf bytes.Buffer
+	if err := formats.WritePO(&buf, catalog, false); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "msgstr[2] \"# файлов\"") {
+		t.Fatalf("plural forms not written by gettext index:\n%s", buf.String())
+	}
+	got, err := formats.ParsePO(&buf, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Culture != "ru" || len(got.Entries) != 3 {
+		t.Fatalf("ParsePO culture = %q, entries = %d", got.Culture, len(got.Entries))
+	}
+	first := got.Entries[0]
+	want := catalog.Entries[0]
+	if first.Key != want.Key || first.Type != want.Type || first.Description != want.Description ||
+		first.Context != want.Context || first.MaxLength != want.MaxLength || first.Text != want.Text || !first.Fuzzy {
+		t.Fatalf("ParsePO entry = %+v, want %+v", first, want)
+	}
+	// ru 的 gettext 规则没有 other，导入时使用最后一种形式
+	plurals := map[string]string{"one": "# файл", "few": "# файла", "many": "# файлов", "other": "# файлов"}
+	if !reflect.DeepEqual(got.Entries[1].Plurals, plurals) {
+		t.Fatalf("ParsePO plurals = %v, want %v", got.Entries[1].Plurals, plurals)
+	}
+	if got.Entries[2].Text != "" || got.Entries[2].Plurals != nil {
+		t.Fatalf("untranslated entry = %+v", got.Entries[2])
+	}
+}
+
+func TestPO_ParseErrors(t *testing.T) {
+	cases := []string{
+		"msgid \"a\"\nmsgstr \"b\n",
+		"\"dangling\"\n",
+		"msgid \"a\"\nmsgid_plural \"a\"\nmsgstr[5] \"x\"\n",
+	}
+	for _, c := range cases {
+		if _, err := formats.ParsePO(strings.NewReader(c), "en"); err == nil {
+			t.Fatalf("ParsePO(%q) expected error", c)
+		}
+	}
+}
+
+func TestXLIFF_RoundTrip(t *testing.T) {
+	catalog := &formats.Catalog{Culture: "de", SourceCulture: "en", Entries: []formats.Entry{
+		{Key: "home.title", Description: "Page title", MaxLength: 20, Source: "Home & <away>", Text: "Startseite", Fuzzy: true},
+		{Key: "files", Plural: true, Source: "# files", SourcePlurals: map[string]string{"one": "# file", "other": "# files"},
+			Text: "# Dateien", Plurals: map[string]string{"one": "# Datei", "other": "# Dateien"}},
+		{Key: "empty", Source: "Empty"},
+	}}
+	writers := map[string]func(*bytes.Buffer, *formats.Catalog) error{
+		"1.2": func(b *bytes.Buffer, c *formats.Catalog) error { return formats.WriteXLIFF12(b, c) },
+		"2.0": func(b *bytes.Buffer, c *formats.Catalog) error { return formats.WriteXLIFF20(b, c) },
+	}
+	for version, write := range writers {
+		var buf bytes.Buffer
+		if err := write(&buf, catalog); err != nil {
+			t.Fatal(err)
+		}
+		got, err := formats.ParseXLIFF(&buf)
+		if err != nil {
+			t.Fatalf("XLIFF %s: %v", version, err)
+		}
+		if got.Culture != "de" || got.SourceCulture != "en" || len(got.Entries) != 3 {
+			t.Fatalf("XLIFF %s: culture = %q, source = %q, entries = %d", version, got.Culture, got.SourceCulture, len(got.Entries))
+		}
+		if e := got.Entries[0]; e.Key != "home.title" || e.Source != "Home & <away>" || e.Text != "Startseite" || !e.Fuzzy {
+			t.Fatalf("XLIFF %s: entry = %+v", version, e)
+		}
+		if e := got.Entries[1]; !e.Plural || e.Fuzzy || !reflect.DeepEqual(e.Plurals, catalog.Entries[1].Plurals) || e.Source != "# files" {
+			t.Fatalf("XLIFF %s: plural entry = %+v", version, e)
+		}
+		if e := got.Entries[2]; e.Text != "" || e.Source != "Empty" {
+			t.Fatalf("XLIFF %s: untranslated entry = %+v", version, e)
+		}
+	}
+}
+
+func TestXLIFF_States(t *testing.T) {
+	doc := `<xliff xmlns="urn:oasis:names:tc:xliff:document:1.2" version="1.2">
+<file source-language="en" target-language="fr"><body>
+<trans-unit id="a"><source>A</source><target state="new">ignored</target></trans-unit>
+<trans-unit id="b"><source>B</source><target state="signed-off">Bé</target></trans-unit>
+<trans-unit id="c"><source>C</source><target state="needs-review-l10n">Cé</target></trans-unit>
+</body></file></xliff>`
+	got, err := formats.ParseXLIFF(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Entries[0].Text != "" || got.Entries[1].Text != "Bé" || got.Entries[1].Fuzzy || !got.Entries[2].Fuzzy {
+		t.Fatalf("ParseXLIFF states = %+v", got.Entries)
+	}
+}
+
+func TestGoI18n_RoundTrip(t *testing.T) {
+	catalog := &formats.Catalog{Culture: "es", SourceCulture: "en", Entries: []formats.Entry{
+		{Key: "PersonCats", Description: "The number of cats a person has", Plural: true, Source: "{{.Name}} has {{.Count}} cats.",
+			Text: "{{.Name}} tiene {{.Count}} gatos.", Plurals: map[string]string{"one": "{{.Name}} tiene {{.Count}} gato.", "other": "{{.Name}} tiene {{.Count}} gatos."}},
+		{Key: "home.title", Source: "Home", Text: "Inicio"},
+		{Key: "empty", Source: "Empty"},
+		{Key: "draft", Source: "Draft", Text: "Borrador", Fuzzy: true},
+	}}
+	encodings := map[string]formats.GoI18nEncoding{"toml": formats.GoI18nTOML, "json": formats.GoI18nJSON}
+	for name, encoding := range encodings {
+		var buf bytes.Buffer
+		if err := formats.WriteGoI18n(&buf, catalog, encoding); err != nil {
+			t.Fatal(err)
+		}
+		// 与 go-i18n 文档中 goi18n merge 生成的哈希一致
+		if !strings.Contains(buf.String(), "sha1-f937a0e05e19bfe6cd70937c980eaf1f9832f091") {
+			t.Fatalf("%s: hash not written:\n%s", name, buf.String())
+		}
+		got, err := formats.ParseGoI18n(&buf, encoding)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(got.Entries) != 2 {
+			t.Fatalf("%s: entries = %+v, want untranslated and unapproved entries skipped", name, got.Entries)
+		}
+		cats, title := got.Entries[0], got.Entries[1]
+		if cats.Key != "PersonCats" || !cats.Plural || cats.Description != catalog.Entries[0].Description ||
+			cats.Text != catalog.Entries[0].Text || !reflect.DeepEqual(cats.Plurals, catalog.Entries[0].Plurals) {
+			t.Fatalf("%s: plural entry = %+v", name, cats)
+		}
+		if title.Key != "home.title" || title.Text != "Inicio" || title.Plural || title.Hash != formats.GoI18nHash("", "Home") {
+			t.Fatalf("%s: entry = %+v", name, title)
+		}
+	}
+}
+
+func TestGoI18n_Parse(t *testing.T) {
+	content := "Title = \"Home\"\n\n[menu]\nopen = \"Open\"\n\n[menu.files]\ndescription = \"Files\"\nOne = \"# file\"\nother = \"# files\"\n"
+	got, err := formats.ParseGoI18n(strings.NewReader(content), formats.GoI18nTOML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]string, 0, len(got.Entries))
+	for _, e := range got.Entries {
+		keys = append(keys, e.Key)
+	}
+	if want := []string{"Title", "menu.files", "menu.open"}; !reflect.DeepEqual(keys, want) {
+		t.Fatalf("ParseGoI18n keys = %v, want %v", keys, want)
+	}
+	if files := got.Entries[1]; !files.Plural || files.Plurals["one"] != "# file" || files.Text != "# files" {
+		t.Fatalf("nested plural entry = %+v", files)
+	}
+	if _, err := formats.ParseGoI18n(strings.NewReader(`{"a": {"other": "x", "b": "y"}}`), formats.GoI18nJSON); err == nil {
+		t.Fatal("ParseGoI18n expected error for reserved keys mixed with message ids")
+	}
+}
+
+func TestAndroid_RoundTrip(t *testing.T) {
+	catalog := &formats.Catalog{Culture: "ru", SourceCulture: "en", Entries: []formats.Entry{
+		{Key: "home.title", Description: "Page title", Text: "It's \"home\"\n@me  twice & <b>"},
+		{Key: "spaced", Text: " padded "},
+		{Key: "files", Plural: true, Text: "# файла", Plurals: map[string]string{"one": "# файл", "few": "# файла", "many": "# файлов", "other": "# файла"}},
+		{Key: "empty"},
+		{Key: "draft", Text: "Черновик", Fuzzy: true},
+	}}
+	var buf bytes.Buffer
+	if err := formats.WriteAndroid(&buf, catalog); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `It\'s \"home\"\n`) || !strings.Contains(buf.String(), `<item quantity="many"># файлов</item>`) {
+		t.Fatalf("unexpected strings.xml:\n%s", buf.String())
+	}
+	got, err := formats.ParseAndroid(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Entries) != 3 {
+		t.Fatalf("ParseAndroid entries = %+v, want untranslated and unapproved entries skipped", got.Entries)
+	}
+	for i, e := range got.Entries {
+		want := catalog.Entries[i]
+		if e.Key != want.Key || e.Description != want.Description || e.Text != want.Text || e.Plural != want.Plural || !reflect.DeepEqual(e.Plurals, want.Plurals) {
+			t.Fatalf("ParseAndroid entry = %+v, want %+v", e, want)
+		}
+	}
+	for culture, dir := range map[string]string{"de": "values-de", "pt-BR": "values-pt-rBR", "zh-Hans": "values-b+zh+Hans"} {
+		if got := formats.AndroidValuesDir(culture); got != dir {
+			t.Fatalf("AndroidValuesDir(%s) = %s, want %s", culture, got, dir)
+		}
+	}
+}
+
+func TestAndroid_Parse(t *testing.T) {
+	content := `<resources>
+    <string name="collapsed">  a
+        b A "  kept  " </string>
+    <string name="id" translatable="false">x</string>
+    <string-array name="list"><item>a</item></string-array>
+</resources>`
+	got, err := formats.ParseAndroid(strings.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Entries) != 1 || got.Entries[0].Text != "a b A   kept  " {
+		t.Fatalf("ParseAndroid entries = %+v", got.Entries)
+	}
+	if _, err := formats.ParseAndroid(strings.NewReader(`<resources><string name="g">Hi <xliff:g id="n">%s</xliff:g></string></resources>`)); err == nil {
+		t.Fatal("ParseAndroid expected error for inline markup")
+	}
+}
+
+func TestXMLComment(t *testing.T) {
+	// 描述写为 XML 注释，注释中不能出现 --，也不能以 - 结尾
+	descriptions := []string{"a---b", "a----b", "ends-", "--"}
+	for _, description := range descriptions {
+		catalog := &formats.Catalog{Culture: "de", Entries: []formats.Entry{
+			{Key: "title", Description: description, Text: "Titel"},
+			{Key: "files", Description: description, Plural: true, Text: "# Dateien", Plurals: map[string]string{"one": "# Datei", "other": "# Dateien"}},
+		}}
+		var android, dict bytes.Buffer
+		if err := formats.WriteAndroid(&android, catalog); err != nil {
+			t.Fatal(err)
+		}
+		if err := formats.WriteAppleStringsdict(&dict, catalog); err != nil {
+			t.Fatal(err)
+		}
+		for name, buf := range map[string]*bytes.Buffer{"strings.xml": &android, "stringsdict": &dict} {
+			for _, comment := range strings.Split(buf.String(), "<!--")[1:] {
+				body := comment[:strings.Index(comment, "-->")]
+				if strings.Contains(body, "--") || strings.HasSuffix(body, "-") {
+					t.Fatalf("%s: invalid comment %q for description %q", name, body, description)
+				}
+			}
+		}
+		if _, err := formats.ParseAndroid(&android); err != nil {
+			t.Fatalf("ParseAndroid description %q: %v", description, err)
+		}
+		if _, err := formats.ParseAppleStringsdict(&dict); err != nil {
+			t.Fatalf("ParseAppleStringsdict description %q: %v", description, err)
+		}
+	}
+}
+
+func TestApple_RoundTrip(t *testing.T) {
+	catalog := &formats.Catalog{Culture: "de", SourceCulture: "en", Entries: []formats.Entry{
+		{Key: "home.title", Description: "Page title", Text: "Start \"seite\"\n\\ ok"},
+		{Key: "files", Description: "File count", Plural: true, Text: "# Dateien", Plurals: map[string]string{"one": "# Datei", "other": "# Dateien"}},
+		{Key: "empty"},
+		{Key: "draft", Text: "Entwurf", Fuzzy: true},
+		{Key: "draft.files", Plural: true, Text: "# Entwürfe", Plurals: map[string]string{"one": "# Entwurf", "other": "# Entwürfe"}, Fuzzy: true},
+	}}
+	var strs, dict bytes.Buffer
+	if err := formats.WriteAppleStrings(&strs, catalog); err != nil {
+		t.Fatal(err)
+	}
+	if err := formats.WriteAppleStringsdict(&dict, catalog); err != nil {
+		t.Fatal(err)
+	}
+	got, err := formats.ParseAppleStrings(&strs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Entries) != 1 || got.Entries[0].Key != "home.title" || got.Entries[0].Text != catalog.Entries[0].Text || got.Entries[0].Description != "Page title" {
+		t.Fatalf("ParseAppleStrings entries = %+v", got.Entries)
+	}
+	got, err = formats.ParseAppleStringsdict(&dict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Entries) != 1 || got.Entries[0].Key != "files" || got.Entries[0].Text != "# Dateien" ||
+		got.Entries[0].Description != "File count" || !reflect.DeepEqual(got.Entries[0].Plurals, catalog.Entries[1].Plurals) {
+		t.Fatalf("ParseAppleStringsdict entries = %+v", got.Entries)
+	}
+}
+
+func TestApple_Parse(t *testing.T) {
+	content := "/* No comment provided by engineer. */\n\"a\" = \"\\U00e9t\\u00e9\";\n// line comment\nplain_key = \"b\";\n\"same\";\n"
+	got, err := formats.ParseAppleStrings(strings.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []formats.Entry{{Key: "a", Text: "été"}, {Key: "plain_key", Description: "line comment", Text: "b"}, {Key: "same", Text: "same"}}
+	if !reflect.DeepEqual(got.Entries, want) {
+		t.Fatalf("ParseAppleStrings entries = %+v, want %+v", got.Entries, want)
+	}
+	if _, err := formats.ParseAppleStrings(strings.NewReader(`"a" = "b"`)); err == nil {
+		t.Fatal("ParseAppleStrings expected error for missing semicolon")
+	}
+	dict := `<plist version="1.0"><dict><key>left</key><dict>
+<key>NSStringLocalizedFormatKey</key><string>%#@n@ left</string>
+<key>n</key><dict><key>NSStringFormatSpecTypeKey</key><string>NSStringPluralRuleType</string>
+<key>one</key><string>%d item</string><key>other</key><string>%d items</string></dict>
+</dict></dict></plist>`
+	parsed, err := formats.ParseAppleStringsdict(strings.NewReader(dict))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := parsed.Entries[0]; e.Plurals["one"] != "%d item left" || e.Text != "%d items left" {
+		t.Fatalf("ParseAppleStringsdict entry = %+v", e)
+	}
+}
+
+func TestRESX_RoundTrip(t *testing.T) {
+	catalog := &formats.Catalog{Culture: "de", SourceCulture: "en", Entries: []formats.Entry{
+		{Key: "Greeting", Type: "Web", Description: "Shown on <home> & about", Text: "Hallo \"<b>Welt</b>\" & Co.\r\nZeile  zwei "},
+		{Key: "Files", Type: "Web", Plural: true, Text: "{0} Dateien", Plurals: map[string]string{"one": "{0} Datei", "other": "{0} Dateien"}},
+		{Key: "Empty", Type: "Web"},
+		{Key: "Draft", Type: "Web", Text: "Entwurf", Fuzzy: true},
+	}}
+	var buf bytes.Buffer
+	if err := formats.WriteRESX(&buf, catalog); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `<data name="Files[one]" xml:space="preserve">`) ||
+		!strings.Contains(buf.String(), "<comment>Shown on &lt;home&gt; &amp; about</comment>") {
+		t.Fatalf("unexpected resx:\n%s", buf.String())
+	}
+	got, err := formats.ParseRESX(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Entries) != 2 {
+		t.Fatalf("ParseRESX entries = %+v, want untranslated and unapproved entries skipped", got.Entries)
+	}
+	if e := got.Entries[0]; e.Key != "Greeting" || e.Text != catalog.Entries[0].Text || e.Description != catalog.Entries[0].Description {
+		t.Fatalf("ParseRESX entry = %+v", e)
+	}
+	if e := got.Entries[1]; e.Key != "Files" || !e.Plural || e.Text != "{0} Dateien" || !reflect.DeepEqual(e.Plurals, catalog.Entries[1].Plurals) {
+		t.Fatalf("ParseRESX plural entry = %+v", e)
+	}
+	invalid := &formats.Catalog{Entries: []formats.Entry{{Key: "bell", Text: "\a"}}}
+	if err := formats.WriteRESX(&bytes.Buffer{}, invalid); err == nil {
+		t.Fatal("WriteRESX expected error for character not allowed in XML")
+	}
+}
+
+func TestRESX_ParseSkipsNonStrings(t *testing.T) {
+	content := `<?xml version="1.0" encoding="utf-8"?>
+<root>
+  <resheader name="resmimetype"><value>text/microsoft-resx</value></resheader>
+  <assembly alias="System.Drawing" name="System.Drawing, Version=4.0.0.0" />
+  <data name="Logo" type="System.Drawing.Bitmap, System.Drawing" mimetype="application/x-microsoft.net.object.bytearray.base64"><value>AAAA</value></data>
+  <data name="Title" type="System.String, mscorlib"><value>Titel</value></data>
+</root>`
+	got, err := formats.ParseRESX(strings.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []formats.Entry{{Key: "Title", Text: "Titel"}}; !reflect.DeepEqual(got.Entries, want) {
+		t.Fatalf("ParseRESX entries = %+v, want %+v", got.Entries, want)
+	}
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"i18n-service/proto"
+	"i18n-service/rpc"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// findKeyID 获取项目中资源key的ID，不存在时为0
+func findKeyID(t *testing.T, rpcServer *rpc.CulturesRpc, project, name string) int32 {
+	t.Helper()
+	keys, err := rpcServer.CulturesResourceKeyFeature(context.Background(), &proto.CultureKeysRequest{
+		Action: proto.ActionTypes_List, ParamData: &proto.CultureKeyItem{Name: name}, Index: 1, Size: 100, Project: project,
+	})
+	if err != nil {
+		t.Fatalf("CulturesResourceKeyFeature failed: %v", err)
+	}
+	if keys.Code != proto.ReplyCode_Success {
+		t.Fatalf("CulturesResourceKeyFeature failed: %v", keys.Message)
+	}
+	for _, item := range keys.Items {
+		if item.Name == name {
+			return item.Id
+		}
+	}
+	return 0
+}
+
+// findLang 获取资源key在语言中的翻译
+func findLang(t *testing.T, rpcServer *rpc.CulturesRpc, cultureID, keyID int32, name string) *proto.CultureKeyValueItem {
+	t.Helper()
+	langs, err := rpcServer.CulturesResourceKeyValueFeature(context.Background(), &proto.CultureKeyValuesRequest{
+		Action: proto.ActionTypes_List, ParamData: &proto.CultureKeyValueItem{CultureId: cultureID}, SearchKey: name, Index: 1, Size: 100,
+	})
+	if err != nil {
+		t.Fatalf("CulturesResourceKeyValueFeature failed: %v", err)
+	}
+	for _, item := range langs.Items {
+		if item.KeyId == keyID {
+			return item
+		}
+	}
+	return nil
+}
+
+func TestCulturesRpc_HistoryRestore(t *testing.T) {
+	rpcServer := rpc.NewCulturesRpc(configManager)
+	reference, target := importCultures(t, rpcServer)
+	prefix := fmt.Sprintf("test.history%d.", time.Now().UnixNano())
+	name := prefix + "title"
+	addImportKeys(t, rpcServer, reference.Id, prefix, map[string]string{name: "Title"})
+	keyID := findKeyID(t, rpcServer, "", name)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-i18n-actor", "history-test"))
+	for _, text := range []string{"first", "second"} {
+		response, err := rpcServer.AddResourceKeyValue(ctx, &proto.AddCultureKeyValueRequest{
+			Key:    name,
+			Values: []*proto.CultureKeyValue{{CultureId: target.Id, Text: text, Status: proto.TranslationStatuses_Draft}},
+		})
+		if err != nil {
+			t.Fatalf("AddResourceKeyValue failed: %v", err)
+		}
+		if response.Code != proto.ReplyCode_Success {
+			t.Fatalf("AddResourceKeyValue failed: %v", response.Message)
+		}
+	}
+
+	// 只返回该语言的翻译历史及key本身的历史，按时间倒序
+	history, err := rpcServer.GetResourceHistory(context.Background(), &proto.ResourceHistoryRequest{KeyId: keyID, CultureIds: []int32{target.Id}, Index: 1, Size: 10})
+	if err != nil {
+		t.Fatalf("GetResourceHistory failed: %v", err)
+	}
+	if history.Code != proto.ReplyCode_Success {
+		t.Fatalf("GetResourceHistory failed: %v", history.Message)
+	}
+	var actions []string
+	var created *proto.ResourceHistoryItem
+	for _, item := range history.Items {
+		if item.Entity != "lang" {
+			continue
+		}
+		if item.CultureId != target.Id || item.Actor != "history-test" {
+			t.Fatalf("history item = %+v, want culture %d by history-test", item, target.Id)
+		}
+		actions = append(actions, item.Action)
+		if item.Action == "create" {
+			created = item
+		}
+	}
+	if fmt.Sprint(actions) != "[update create]" || created == nil {
+		t.Fatalf("lang history actions = %v, want [update create]", actions)
+	}
+
+	// 还原为新增时的翻译，还原的翻译需要重新审核
+	restored, err := rpcServer.RestoreResourceRevision(context.Background(), &proto.RestoreRevisionRequest{Id: created.Id})
+	if err != nil {
+		t.Fatalf("RestoreResourceRevision failed: %v", err)
+	}
+	if restored.Code != proto.ReplyCode_Success {
+		t.Fatalf("RestoreResourceRevision failed: %v", restored.Message)
+	}
+	lang := findLang(t, rpcServer, target.Id, keyID, name)
+	if lang == nil || lang.Text != "first" || lang.Status != proto.TranslationStatuses_NeedsReview || lang.Reviewer != "" {
+		t.Fatalf("restored lang = %+v, want first needing review", lang)
+	}
+}
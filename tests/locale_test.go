@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"context"
+	"i18n-service/data/entity"
+	"i18n-service/data/repository"
+	"i18n-service/rpc"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNegotiateCulture(t *testing.T) {
+	cultures := []entity.CulturesResources{
+		{ID: 1, Code: "de"},
+		{ID: 2, Code: "en", IsDefault: true},
+		{ID: 3, Code: "zh-Hans"},
+		{ID: 4, Code: "zh-Hant"},
+		{ID: 5, Code: "fr", Status: repository.CultureBeta},
+		{ID: 6, Code: "es", Status: repository.CultureDisabled},
+	}
+	tests := []struct {
+		acceptLanguage string
+		want           string
+	}{
+		{"", "en"},
+		{"de-CH,de;q=0.9", "de"},
+		{"zh-TW", "zh-Hant"},
+		{"zh-CN,zh;q=0.9", "zh-Hans"},
+		{"ja,de;q=0.5", "de"},
+		{"ja", "en"},
+		{"not a language", "en"},
+		// 测试和停用的语言不参与协商
+		{"fr-FR,fr", "en"},
+		{"es", "en"},
+	}
+	for _, tt := range tests {
+		got, err := rpc.NegotiateCulture(cultures, tt.acceptLanguage)
+		if err != nil {
+			t.Fatalf("NegotiateCulture(%q): %v", tt.acceptLanguage, err)
+		}
+		if got != tt.want {
+			t.Fatalf("NegotiateCulture(%q) = %s, want %s", tt.acceptLanguage, got, tt.want)
+		}
+	}
+	if _, err := rpc.NegotiateCulture(nil, "de"); err == nil {
+		t.Fatal("NegotiateCulture expected error without cultures")
+	}
+}
+
+func TestMetadataContext_GetAcceptLanguage(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("Accept-Language", "de-CH", "accept-language", "en;q=0.5"))
+	if got := rpc.NewMetadataContext(ctx).GetAcceptLanguage(); got != "de-CH,en;q=0.5" {
+		t.Fatalf("GetAcceptLanguage = %q", got)
+	}
+	if got := rpc.NewMetadataContext(context.Background()).GetAcceptLanguage(); got != "" {
+		t.Fatalf("GetAcceptLanguage without metadata = %q", got)
+	}
+}
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"i18n-service/data/entity"
+	"i18n-service/data/migration"
+	v1 "i18n-service/data/migration/v1"
+	"i18n-service/data/repository"
+	"testing"
+
+	"xorm.io/xorm"
+)
+
+func TestMigrations_Versions(t *testing.T) {
+	list := migration.Migrations()
+	if len(list) == 0 {
+		t.Fatal("no migrations")
+	}
+	for i, m := range list {
+		if m.Version != int64(i+1) || m.Name == "" || m.Up == nil {
+			t.Fatalf("migration %d = %d %q, want consecutive versions from 1 with a name", i, m.Version, m.Name)
+		}
+	}
+}
+
+func TestMigrations_V1Snapshot(t *testing.T) {
+	// sql.Open 不连接数据库，只用于按引擎的映射规则解析表结构
+	db, err := xorm.NewEngine("mysql", "root:root@tcp(127.0.0.1:3306)/i18n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	// 版本 1 的表结构快照与当前实体映射到同一张表，快照中的列在当前实体中仍然存在
+	tables := []struct {
+		snapshot interface{}
+		current  interface{}
+	}{
+		{new(v1.CulturesResources), new(entity.CulturesResources)},
+		{new(v1.CulturesResourceTypes), new(entity.CulturesResourceTypes)},
+		{new(v1.CulturesResourceKeys), new(entity.CulturesResourceKeys)},
+		{new(v1.CulturesResourceLangs), new(entity.CulturesResourceLangs)},
+		{new(v1.CulturesResourcePlurals), new(entity.CulturesResourcePlurals)},
+		{new(v1.CulturesResourceHistories), new(entity.CulturesResourceHistories)},
+		{new(v1.CulturesResourceTags), new(entity.CulturesResourceTags)},
+		{new(v1.CulturesResourceKeyTags), new(entity.CulturesResourceKeyTags)},
+		{new(v1.CulturesProjects), new(entity.CulturesProjects)},
+		{new(v1.CulturesProjectCultures), new(entity.CulturesProjectCultures)},
+	}
+	for _, tt := range tables {
+		snapshot, err := db.TableInfo(tt.snapshot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		current, err := db.TableInfo(tt.current)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if snapshot.Name != current.Name {
+			t.Fatalf("snapshot table %s, want %s", snapshot.Name, current.Name)
+		}
+		for _, column := range snapshot.ColumnsSeq() {
+			if current.GetColumn(column) == nil {
+				t.Fatalf("%s: column %s dropped from the entity without a migration", current.Name, column)
+			}
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	if _, err := repository.Migrate(configManager); err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	// 已执行的版本不会重复执行
+	done, err := repository.Migrate(configManager)
+	if err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	if len(done) != 0 {
+		t.Fatalf("Migrate applied %d migrations again", len(done))
+	}
+	status, err := repository.MigrationStatus(configManager)
+	if err != nil {
+		t.Fatalf("MigrationStatus failed: %v", err)
+	}
+	for _, v := range status {
+		if !v.Applied {
+			t.Fatalf("migration %d %s not applied", v.Version, v.Name)
+		}
+	}
+}
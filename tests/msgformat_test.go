@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"errors"
+	"i18n-service/msgformat"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMsgFormat_Format(t *testing.T) {
+	cases := []struct {
+		locale  string
+		pattern string
+		args    map[string]interface{}
+		want    string
+	}{
+		{"en", "Hello {name}, you have {count, plural, one {# item} other {# items}}", map[string]interface{}{"name": "Bob", "count": int64(1)}, "Hello Bob, you have 1 item"},
+		{"en", "Hello {name}, you have {count, plural, one {# item} other {# items}}", map[string]interface{}{"name": "Bob", "count": int64(1234)}, "Hello Bob, you have 1,234 items"},
+		{"ru", "{n, plural, one {# файл} few {# файла} many {# файлов} other {# файла}}", map[string]interface{}{"n": int64(5)}, "5 файлов"},
+		{"en", "{n, plural, offset:1 =0 {nobody} =1 {{who}} other {{who} and # others}}", map[string]interface{}{"n": int64(3), "who": "Ann"}, "Ann and 2 others"},
+		{"en", "{n, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}", map[string]interface{}{"n": int64(23)}, "23rd"},
+		{"en", "{g, select, male {he} female {she} other {they}}", map[string]interface{}{"g": "female"}, "she"},
+		{"en", "{d, date, yyyy-MM-dd} it''s '{literal}'", map[string]interface{}{"d": time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)}, "2026-01-02 it's {literal}"},
+		{"en", "{d, date, medium} {d, time, short}", map[string]interface{}{"d": time.Date(2026, 3, 5, 14, 7, 0, 0, time.UTC)}, "Mar 5, 2026 2:07 PM"},
+		{"de", "{d, date, medium} {d, date, long} {d, time, short}", map[string]interface{}{"d": time.Date(2026, 3, 5, 14, 7, 0, 0, time.UTC)}, "05.03.2026 5. März 2026 14:07"},
+		{"es-MX", "{d, date, long}", map[string]interface{}{"d": time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)}, "5 de marzo de 2026"},
+		{"ja", "{d, date, full}", map[string]interface{}{"d": time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)}, "2026年3月5日木曜日"},
+		{"sw", "{d, date, medium}", map[string]interface{}{"d": time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)}, "2026 Mar 5"},
+	}
+	for _, c := range cases {
+		got, err := msgformat.Format(c.locale, c.pattern, c.args)
+		if err != nil {
+			t.Fatalf("Format(%q) failed: %v", c.pattern, err)
+		}
+		if got != c.want {
+			t.Fatalf("Format(%q) = %q, want %q", c.pattern, got, c.want)
+		}
+	}
+}
+
+func TestMsgFormat_Errors(t *testing.T) {
+	var syntaxErr *msgformat.SyntaxError
+	if _, err := msgformat.Parse("{count, plural, one {x}}"); !errors.As(err, &syntaxErr) {
+		t.Fatalf("expected syntax error for missing other, got %v", err)
+	}
+	if _, err := msgformat.Parse("Hello {name"); !errors.As(err, &syntaxErr) {
+		t.Fatalf("expected syntax error for unclosed argument, got %v", err)
+	}
+	var argErr *msgformat.ArgumentError
+	if _, err := msgformat.Format("en", "Hello {name}", nil); !errors.As(err, &argErr) || argErr.Name != "name" {
+		t.Fatalf("expected missing argument error, got %v", err)
+	}
+	if _, err := msgformat.Format("en", "{n, number}", map[string]interface{}{"n": "x"}); !errors.As(err, &argErr) {
+		t.Fatalf("expected argument type error, got %v", err)
+	}
+}
+
+func TestMsgFormat_PluralCategories(t *testing.T) {
+	cases := map[string]string{
+		"en": "one,other",
+		"ru": "one,few,many,other",
+		"ja": "other",
+		"ar": "zero,one,two,few,many,other",
+	}
+	for locale, want := range cases {
+		got := strings.Join(msgformat.PluralCategories(locale), ",")
+		if got != want {
+			t.Fatalf("PluralCategories(%q) = %q, want %q", locale, got, want)
+		}
+	}
+}
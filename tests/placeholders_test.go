@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"i18n-service/data/repository"
+	"strings"
+	"testing"
+)
+
+func TestComparePlaceholders(t *testing.T) {
+	cases := []struct {
+		reference  string
+		text       string
+		missing    string
+		unexpected string
+	}{
+		{"Hello {name}", "Bonjour {name}", "", ""},
+		{"Hello {name}", "Bonjour {nom}", "{name}", "{nom}"},
+		{"{count, plural, one {# item for {who}} other {# items}}", "{count, plural, one {# élément} other {# éléments pour {who}}}", "", ""},
+		{"%s has %d items", "%s a des éléments", "%d", ""},
+		{"%s and %s", "%s", "%s", ""},
+		{"100%% done, {{count}} left", "100%% fait, {{ count }} restants", "", ""},
+		{"Hi {{user}}", "Salut %@", "{{user}}", "%@"},
+		{"Hello {name} {", "Bonjour {", "{name}", ""},
+	}
+	for _, c := range cases {
+		m := repository.ComparePlaceholders(c.reference, c.text)
+		if got := strings.Join(m.Missing, ","); got != c.missing {
+			t.Fatalf("ComparePlaceholders(%q, %q) missing = %q, want %q", c.reference, c.text, got, c.missing)
+		}
+		if got := strings.Join(m.Unexpected, ","); got != c.unexpected {
+			t.Fatalf("ComparePlaceholders(%q, %q) unexpected = %q, want %q", c.reference, c.text, got, c.unexpected)
+		}
+	}
+}
+
+func TestCompareFormPlaceholders(t *testing.T) {
+	reference := map[string]string{"one": "{count} file in {dir}", "other": "{count} files in {dir}"}
+	plurals := map[string]string{
+		"one":  "{count} файл в {dir}",
+		"few":  "{count} файла",
+		"many": "{count} файлов в {dir}",
+	}
+	list := repository.CompareFormPlaceholders(reference["other"], reference, "", plurals)
+	if len(list) != 1 {
+		t.Fatalf("CompareFormPlaceholders() = %+v, want 1 mismatch", list)
+	}
+	if m := list[0]; m.Category != "few" || m.Reference != reference["other"] || strings.Join(m.Missing, ",") != "{dir}" {
+		t.Fatalf("CompareFormPlaceholders() = %+v, want few missing {dir} against other", m)
+	}
+	list = repository.CompareFormPlaceholders("{count} files", nil, "{n} Dateien", nil)
+	if len(list) != 1 || list[0].Category != "" || strings.Join(list[0].Unexpected, ",") != "{n}" {
+		t.Fatalf("CompareFormPlaceholders() = %+v, want text mismatch with unexpected {n}", list)
+	}
+}
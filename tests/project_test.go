@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"i18n-service/proto"
+	"i18n-service/rpc"
+	"testing"
+	"time"
+)
+
+// addTestProject 新增只启用指定语言的项目，测试结束时删除
+func addTestProject(t *testing.T, rpcServer *rpc.CulturesRpc, code string, cultureIds []int32) {
+	t.Helper()
+	response, err := rpcServer.CulturesProjectFeature(context.Background(), &proto.CultureProjectsRequest{
+		Action: proto.ActionTypes_AddOrUpdate, ParamData: &proto.CultureProjectItem{Code: code, Name: code, CultureIds: cultureIds},
+	})
+	if err != nil {
+		t.Fatalf("CulturesProjectFeature failed: %v", err)
+	}
+	if response.Code != proto.ReplyCode_Success {
+		t.Fatalf("CulturesProjectFeature failed: %v", response.Message)
+	}
+	t.Cleanup(func() {
+		projects, err := rpcServer.CulturesProjectFeature(context.Background(), &proto.CultureProjectsRequest{
+			Action: proto.ActionTypes_List, ParamData: &proto.CultureProjectItem{Code: code}, Index: 1, Size: 10,
+		})
+		if err != nil {
+			return
+		}
+		for _, item := range projects.Items {
+			if item.Code == code {
+				rpcServer.CulturesProjectFeature(context.Background(), &proto.CultureProjectsRequest{
+					Action: proto.ActionTypes_Delete, ParamData: &proto.CultureProjectItem{Id: item.Id},
+				})
+			}
+		}
+	})
+}
+
+func TestCulturesRpc_ProjectScoping(t *testing.T) {
+	rpcServer := rpc.NewCulturesRpc(configManager)
+	reference, target := importCultures(t, rpcServer)
+	suffix := time.Now().UnixNano()
+	project := fmt.Sprintf("test%d", suffix)
+	addTestProject(t, rpcServer, project, []int32{reference.Id, target.Id})
+	prefix := fmt.Sprintf("test.project%d.", suffix)
+	name := prefix + "title"
+	addImportKeys(t, rpcServer, reference.Id, prefix, map[string]string{name: "Title"})
+
+	// 项目只列出启用的语言
+	cultures, err := rpcServer.CultureFeature(context.Background(), &proto.CulturesRequest{Action: proto.ActionTypes_List, Project: project})
+	if err != nil {
+		t.Fatalf("CultureFeature failed: %v", err)
+	}
+	if cultures.Code != proto.ReplyCode_Success || len(cultures.Items) != 2 {
+		t.Fatalf("project cultures = %v, %v, want the 2 enabled cultures", cultures.Items, cultures.Message)
+	}
+
+	// 默认项目的资源key在其他项目中不可见
+	if findKeyID(t, rpcServer, "", name) == 0 {
+		t.Fatal("key not listed in the default project")
+	}
+	if id := findKeyID(t, rpcServer, project, name); id != 0 {
+		t.Fatalf("default project key %d listed in project %s", id, project)
+	}
+
+	// 其他项目的资源类型不能用于新建资源key，校验失败时不新建资源key
+	types, err := rpcServer.CulturesResourceTypeFeature(context.Background(), &proto.CultureTypesRequest{Action: proto.ActionTypes_List, Index: 1, Size: 1})
+	if err != nil {
+		t.Fatalf("CulturesResourceTypeFeature failed: %v", err)
+	}
+	if len(types.Items) > 0 {
+		response, err := rpcServer.AddResourceKeyValue(context.Background(), &proto.AddCultureKeyValueRequest{
+			Key:     name,
+			TypeId:  int32(types.Items[0].Id),
+			Values:  []*proto.CultureKeyValue{{CultureId: reference.Id, Text: "Title"}},
+			Project: project,
+		})
+		if err != nil {
+			t.Fatalf("AddResourceKeyValue failed: %v", err)
+		}
+		if response.Code != proto.ReplyCode_InvalidParam {
+			t.Fatalf("AddResourceKeyValue with another project's type = %v, %s, want InvalidParam", response.Code, response.Message)
+		}
+		if id := findKeyID(t, rpcServer, project, name); id != 0 {
+			t.Fatalf("rejected key %d created in project %s", id, project)
+		}
+	}
+
+	// 不存在的项目
+	unknown, err := rpcServer.CulturesResourceKeyFeature(context.Background(), &proto.CultureKeysRequest{Action: proto.ActionTypes_List, Index: 1, Size: 1, Project: project + "-missing"})
+	if err != nil {
+		t.Fatalf("CulturesResourceKeyFeature failed: %v", err)
+	}
+	if unknown.Code != proto.ReplyCode_NotFound {
+		t.Fatalf("unknown project code = %v, want NotFound", unknown.Code)
+	}
+}
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"errors"
+	"i18n-service/data/entity"
+	"i18n-service/data/repository"
+	"testing"
+)
+
+func TestCheckTransition(t *testing.T) {
+	statuses := []int32{repository.StatusDraft, repository.StatusNeedsReview, repository.StatusApproved, repository.StatusRejected}
+	// 允许的流转，其余均不合法；审核通过或驳回只能从待审核流转
+	allowed := map[[2]int32]bool{
+		{repository.StatusDraft, repository.StatusNeedsReview}:    true,
+		{repository.StatusNeedsReview, repository.StatusApproved}: true,
+		{repository.StatusNeedsReview, repository.StatusRejected}: true,
+		{repository.StatusNeedsReview, repository.StatusDraft}:    true,
+		{repository.StatusApproved, repository.StatusNeedsReview}: true,
+		{repository.StatusRejected, repository.StatusDraft}:       true,
+		{repository.StatusRejected, repository.StatusNeedsReview}: true,
+	}
+	for _, from := range statuses {
+		for _, to := range append(statuses, 4) {
+			err := repository.CheckTransition(from, to)
+			if want := allowed[[2]int32{from, to}]; (err == nil) != want {
+				t.Fatalf("CheckTransition(%d, %d) = %v, want allowed %v", from, to, err, want)
+			}
+			if err != nil && !errors.Is(err, repository.ErrInvalidTransition) {
+				t.Fatalf("CheckTransition(%d, %d) = %v, want ErrInvalidTransition", from, to, err)
+			}
+		}
+	}
+}
+
+func TestCultureSnapshot_Visible(t *testing.T) {
+	langs := []entity.CulturesResourceLangs{
+		{KeyID: 1, Text: "draft", Status: repository.StatusDraft},
+		{KeyID: 2, Text: "review", Status: repository.StatusNeedsReview},
+		{KeyID: 3, Text: "approved", Status: repository.StatusApproved},
+		{KeyID: 4, Text: "rejected", Status: repository.StatusRejected},
+	}
+	s := repository.NewCultureSnapshot(entity.CulturesResources{ID: 1, Code: "de"}, langs, nil)
+	tests := []struct {
+		keyID   int32
+		visible bool
+		preview bool
+	}{
+		{1, false, true},
+		{2, false, true},
+		{3, true, true},
+		{4, false, false},
+	}
+	for _, tt := range tests {
+		if got := s.Visible(tt.keyID, false); got != tt.visible {
+			t.Fatalf("Visible(%d, false) = %v, want %v", tt.keyID, got, tt.visible)
+		}
+		if got := s.Visible(tt.keyID, true); got != tt.preview {
+			t.Fatalf("Visible(%d, true) = %v, want %v", tt.keyID, got, tt.preview)
+		}
+	}
+}
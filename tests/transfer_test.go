@@ -0,0 +1,205 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"i18n-service/formats"
+	"i18n-service/proto"
+	"i18n-service/rpc"
+	"strings"
+	"testing"
+	"time"
+)
+
+// importCultures 返回默认语言及一个非默认语言
+func importCultures(t *testing.T, rpcServer *rpc.CulturesRpc) (*proto.CultureItem, *proto.CultureItem) {
+	t.Helper()
+	response, err := rpcServer.CultureFeature(context.Background(), &proto.CulturesRequest{Action: proto.ActionTypes_List})
+	if err != nil {
+		t.Fatalf("CultureFeature failed: %v", err)
+	}
+	if response.Code != proto.ReplyCode_Success {
+		t.Fatalf("CultureFeature failed: %v", response.Message)
+	}
+	var reference, target *proto.CultureItem
+	for _, item := range response.Items {
+		if item.IsDefault {
+			reference = item
+		} else if target == nil {
+			target = item
+		}
+	}
+	if reference == nil || target == nil {
+		t.Skip("need a default and a non-default culture")
+	}
+	return reference, target
+}
+
+// addImportKeys 新增带默认语言翻译的资源key，测试结束时删除名称包含 prefix 的资源key
+func addImportKeys(t *testing.T, rpcServer *rpc.CulturesRpc, cultureID int32, prefix string, sources map[string]string) {
+	t.Helper()
+	for key, text := range sources {
+		response, err := rpcServer.AddResourceKeyValue(context.Background(), &proto.AddCultureKeyValueRequest{
+			Key:    key,
+			Values: []*proto.CultureKeyValue{{CultureId: cultureID, Text: text}},
+		})
+		if err != nil {
+			t.Fatalf("AddResourceKeyValue failed: %v", err)
+		}
+		if response.Code != proto.ReplyCode_Success {
+			t.Fatalf("AddResourceKeyValue %s failed: %v", key, response.Message)
+		}
+	}
+	t.Cleanup(func() {
+		keys, err := rpcServer.CulturesResourceKeyFeature(context.Background(), &proto.CultureKeysRequest{
+			Action: proto.ActionTypes_List, ParamData: &proto.CultureKeyItem{Name: prefix}, Index: 1, Size: 100,
+		})
+		if err != nil {
+			return
+		}
+		for _, item := range keys.Items {
+			if strings.HasPrefix(item.Name, prefix) {
+				rpcServer.CulturesResourceKeyFeature(context.Background(), &proto.CultureKeysRequest{
+					Action: proto.ActionTypes_Delete, ParamData: &proto.CultureKeyItem{Id: item.Id},
+				})
+			}
+		}
+	})
+}
+
+// importedStatuses 返回语言中名称包含 prefix 的资源key的翻译审核状态，键为资源key名称
+func importedStatuses(t *testing.T, rpcServer *rpc.CulturesRpc, cultureID int32, prefix string) map[string]proto.TranslationStatuses {
+	t.Helper()
+	keys, err := rpcServer.CulturesResourceKeyFeature(context.Background(), &proto.CultureKeysRequest{
+		Action: proto.ActionTypes_List, ParamData: &proto.CultureKeyItem{Name: prefix}, Index: 1, Size: 100,
+	})
+	if err != nil {
+		t.Fatalf("CulturesResourceKeyFeature failed: %v", err)
+	}
+	names := make(map[int32]string, len(keys.Items))
+	for _, item := range keys.Items {
+		names[item.Id] = item.Name
+	}
+	langs, err := rpcServer.CulturesResourceKeyValueFeature(context.Background(), &proto.CultureKeyValuesRequest{
+		Action: proto.ActionTypes_List, ParamData: &proto.CultureKeyValueItem{CultureId: cultureID}, SearchKey: prefix, Index: 1, Size: 100,
+	})
+	if err != nil {
+		t.Fatalf("CulturesResourceKeyValueFeature failed: %v", err)
+	}
+	if langs.Code != proto.ReplyCode_Success {
+		t.Fatalf("CulturesResourceKeyValueFeature failed: %v", langs.Message)
+	}
+	statuses := make(map[string]proto.TranslationStatuses, len(langs.Items))
+	for _, item := range langs.Items {
+		if name, ok := names[item.KeyId]; ok {
+			statuses[name] = item.Status
+		}
+	}
+	return statuses
+}
+
+func TestCulturesRpc_ImportXLIFFStatuses(t *testing.T) {
+	rpcServer := rpc.NewCulturesRpc(configManager)
+	reference, target := importCultures(t, rpcServer)
+	prefix := fmt.Sprintf("test.xliff%d.", time.Now().UnixNano())
+	addImportKeys(t, rpcServer, reference.Id, prefix, map[string]string{
+		prefix + "final":   "Final",
+		prefix + "review":  "Review",
+		prefix + "new":     "New",
+		prefix + "changed": "Changed",
+	})
+
+	// final 使用请求的审核状态，未审核通过的状态及原文已变化的单元导入为草稿，new 状态的译文不导入
+	doc := `<xliff xmlns="urn:oasis:names:tc:xliff:document:1.2" version="1.2">
+<file source-language="` + reference.Code + `" target-language="` + target.Code + `" datatype="plaintext" original="messages"><body>
+<trans-unit id="` + prefix + `final"><source>Final</source><target state="final">final</target></trans-unit>
+<trans-unit id="` + prefix + `review"><source>Review</source><target state="needs-review-translation">review</target></trans-unit>
+<trans-unit id="` + prefix + `new"><source>New</source><target state="new">new</target></trans-unit>
+<trans-unit id="` + prefix + `changed"><source>Old source</source><target state="final">changed</target></trans-unit>
+</body></file></xliff>`
+	response, err := rpcServer.ImportResources(context.Background(), &proto.ImportResourcesRequest{
+		Code: target.Code, Format: proto.ResourceFormats_Xliff12, Content: []byte(doc), Status: proto.TranslationStatuses_NeedsReview,
+	})
+	if err != nil {
+		t.Fatalf("ImportResources failed: %v", err)
+	}
+	if response.Code != proto.ReplyCode_Success {
+		t.Fatalf("ImportResources failed: %v", response.Message)
+	}
+	if len(response.Issues) != 1 || response.Issues[0].Key != prefix+"changed" || response.Issues[0].Type != proto.ImportIssueTypes_SourceChanged {
+		t.Fatalf("ImportResources issues = %v, want source changed for %schanged", response.Issues, prefix)
+	}
+
+	statuses := importedStatuses(t, rpcServer, target.Id, prefix)
+	want := map[string]proto.TranslationStatuses{
+		prefix + "final":   proto.TranslationStatuses_NeedsReview,
+		prefix + "review":  proto.TranslationStatuses_Draft,
+		prefix + "changed": proto.TranslationStatuses_Draft,
+	}
+	if len(statuses) != len(want) {
+		t.Fatalf("imported statuses = %v, want %v", statuses, want)
+	}
+	for key, status := range want {
+		if statuses[key] != status {
+			t.Fatalf("imported status of %s = %v, want %v", key, statuses[key], status)
+		}
+	}
+}
+
+func TestCulturesRpc_ImportGoI18nStatuses(t *testing.T) {
+	rpcServer := rpc.NewCulturesRpc(configManager)
+	reference, target := importCultures(t, rpcServer)
+	prefix := fmt.Sprintf("test.goi18n%d.", time.Now().UnixNano())
+	addImportKeys(t, rpcServer, reference.Id, prefix, map[string]string{
+		prefix + "current": "Current",
+		prefix + "stale":   "Stale",
+	})
+
+	// 哈希与当前原文一致的消息使用请求的审核状态，不一致的消息导入为草稿
+	content := fmt.Sprintf(`{%q: {"hash": %q, "other": "current"}, %q: {"hash": %q, "other": "stale"}}`,
+		prefix+"current", formats.GoI18nHash("", "Current"), prefix+"stale", formats.GoI18nHash("", "Old source"))
+	response, err := rpcServer.ImportResources(context.Background(), &proto.ImportResourcesRequest{
+		Code: target.Code, Format: proto.ResourceFormats_GoI18nJson, Content: []byte(content), Status: proto.TranslationStatuses_NeedsReview,
+	})
+	if err != nil {
+		t.Fatalf("ImportResources failed: %v", err)
+	}
+	if response.Code != proto.ReplyCode_Success {
+		t.Fatalf("ImportResources failed: %v", response.Message)
+	}
+	if len(response.Issues) != 1 || response.Issues[0].Key != prefix+"stale" || response.Issues[0].Type != proto.ImportIssueTypes_SourceChanged {
+		t.Fatalf("ImportResources issues = %v, want source changed for %sstale", response.Issues, prefix)
+	}
+
+	statuses := importedStatuses(t, rpcServer, target.Id, prefix)
+	if statuses[prefix+"current"] != proto.TranslationStatuses_NeedsReview || statuses[prefix+"stale"] != proto.TranslationStatuses_Draft {
+		t.Fatalf("imported statuses = %v, want current needs review and stale draft", statuses)
+	}
+}
+
+func TestCulturesRpc_ImportPOFuzzy(t *testing.T) {
+	rpcServer := rpc.NewCulturesRpc(configManager)
+	reference, target := importCultures(t, rpcServer)
+	prefix := fmt.Sprintf("test.po%d.", time.Now().UnixNano())
+	addImportKeys(t, rpcServer, reference.Id, prefix, map[string]string{
+		prefix + "done":  "Done",
+		prefix + "fuzzy": "Fuzzy",
+	})
+
+	// fuzzy 的翻译导入为草稿，其他翻译使用请求的审核状态
+	content := fmt.Sprintf("msgid %q\nmsgstr \"done\"\n\n#, fuzzy\nmsgid %q\nmsgstr \"fuzzy\"\n", prefix+"done", prefix+"fuzzy")
+	response, err := rpcServer.ImportResources(context.Background(), &proto.ImportResourcesRequest{
+		Code: target.Code, Format: proto.ResourceFormats_Po, Content: []byte(content), Status: proto.TranslationStatuses_NeedsReview,
+	})
+	if err != nil {
+		t.Fatalf("ImportResources failed: %v", err)
+	}
+	if response.Code != proto.ReplyCode_Success {
+		t.Fatalf("ImportResources failed: %v", response.Message)
+	}
+
+	statuses := importedStatuses(t, rpcServer, target.Id, prefix)
+	if statuses[prefix+"done"] != proto.TranslationStatuses_NeedsReview || statuses[prefix+"fuzzy"] != proto.TranslationStatuses_Draft {
+		t.Fatalf("imported statuses = %v, want done needs review and fuzzy draft", statuses)
+	}
+}
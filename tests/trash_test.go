@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"i18n-service/proto"
+	"i18n-service/rpc"
+	"testing"
+	"time"
+)
+
+func TestCulturesRpc_TrashRestore(t *testing.T) {
+	rpcServer := rpc.NewCulturesRpc(configManager)
+	reference, _ := importCultures(t, rpcServer)
+	prefix := fmt.Sprintf("test.trash%d.", time.Now().UnixNano())
+	name := prefix + "title"
+	addImportKeys(t, rpcServer, reference.Id, prefix, map[string]string{name: "Title"})
+	keyID := findKeyID(t, rpcServer, "", name)
+
+	deleted, err := rpcServer.CulturesResourceKeyFeature(context.Background(), &proto.CultureKeysRequest{
+		Action: proto.ActionTypes_Delete, ParamData: &proto.CultureKeyItem{Id: keyID},
+	})
+	if err != nil {
+		t.Fatalf("CulturesResourceKeyFeature failed: %v", err)
+	}
+	if deleted.Code != proto.ReplyCode_Success {
+		t.Fatalf("delete key failed: %v", deleted.Message)
+	}
+	if findKeyID(t, rpcServer, "", name) != 0 {
+		t.Fatal("deleted key still listed")
+	}
+
+	// 回收站按删除时间倒序，刚删除的key在首页
+	trash, err := rpcServer.GetResourceTrash(context.Background(), &proto.ResourceTrashRequest{Entity: "key", Index: 1, Size: 100})
+	if err != nil {
+		t.Fatalf("GetResourceTrash failed: %v", err)
+	}
+	if trash.Code != proto.ReplyCode_Success {
+		t.Fatalf("GetResourceTrash failed: %v", trash.Message)
+	}
+	var item *proto.ResourceTrashItem
+	for _, v := range trash.Items {
+		if v.Id == int64(keyID) {
+			item = v
+		}
+	}
+	if item == nil || item.Name != name {
+		t.Fatalf("trash items = %v, want key %s", trash.Items, name)
+	}
+	if item.PurgeAt != nil && !item.PurgeAt.AsTime().After(item.DeletedAt.AsTime()) {
+		t.Fatalf("trash item purge at %v before deleted at %v", item.PurgeAt.AsTime(), item.DeletedAt.AsTime())
+	}
+
+	// 还原key同时还原其翻译
+	restored, err := rpcServer.RestoreResource(context.Background(), &proto.RestoreResourceRequest{Entity: "key", Id: int64(keyID)})
+	if err != nil {
+		t.Fatalf("RestoreResource failed: %v", err)
+	}
+	if restored.Code != proto.ReplyCode_Success {
+		t.Fatalf("RestoreResource failed: %v", restored.Message)
+	}
+	if findKeyID(t, rpcServer, "", name) != keyID {
+		t.Fatal("restored key not listed")
+	}
+	if lang := findLang(t, rpcServer, reference.Id, keyID, name); lang == nil || lang.Text != "Title" {
+		t.Fatalf("restored lang = %+v, want Title", lang)
+	}
+	// 已还原的key不能重复还原
+	again, err := rpcServer.RestoreResource(context.Background(), &proto.RestoreResourceRequest{Entity: "key", Id: int64(keyID)})
+	if err != nil {
+		t.Fatalf("RestoreResource failed: %v", err)
+	}
+	if again.Code == proto.ReplyCode_Success {
+		t.Fatal("RestoreResource restored a key that is not in the trash")
+	}
+}
@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"i18n-service/proto"
+	"i18n-service/rpc"
+	"reflect"
+	"testing"
+)
+
+func TestBuildResourceTree(t *testing.T) {
+	tests := []struct {
+		name      string
+		keys      []string
+		tree      map[string]interface{}
+		conflicts []string
+	}{
+		{"flat", []string{"title", "menu"}, map[string]interface{}{"title": "title", "menu": "menu"}, nil},
+		{"nested", []string{"home.title", "home.menu.open", "home.menu.close"},
+			map[string]interface{}{"home": map[string]interface{}{"title": "home.title", "menu": map[string]interface{}{"open": "home.menu.open", "close": "home.menu.close"}}}, nil},
+		// 叶子与其它键的前缀冲突时保留排序在前的叶子
+		{"leaf before branch", []string{"a.b.c", "a.b"}, map[string]interface{}{"a": map[string]interface{}{"b": "a.b"}}, []string{"a.b.c"}},
+		{"bare key before branch", []string{"a.b", "a"}, map[string]interface{}{"a": "a"}, []string{"a.b"}},
+		{"empty segments", []string{"a..b", ".a", "a.", "ok"}, map[string]interface{}{"ok": "ok"}, []string{".a", "a.", "a..b"}},
+	}
+	for _, tt := range tests {
+		items := make([]*proto.CultureResourceItem, 0, len(tt.keys))
+		for _, key := range tt.keys {
+			items = append(items, &proto.CultureResourceItem{Key: key, Text: key})
+		}
+		tree, conflicts, err := rpc.BuildResourceTree(items)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got := tree.AsMap(); !reflect.DeepEqual(got, tt.tree) {
+			t.Fatalf("%s: tree = %v, want %v", tt.name, got, tt.tree)
+		}
+		if !reflect.DeepEqual(conflicts, tt.conflicts) {
+			t.Fatalf("%s: conflicts = %v, want %v", tt.name, conflicts, tt.conflicts)
+		}
+	}
+}